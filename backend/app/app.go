@@ -5,29 +5,53 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
 	"github.com/kishansakhiya/wails-demo/backend/app/database"
-	"github.com/kishansakhiya/wails-demo/backend/app/services"
+	"github.com/kishansakhiya/wails-demo/backend/app/history"
+	"github.com/kishansakhiya/wails-demo/backend/app/live"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/procctl"
 	"github.com/kishansakhiya/wails-demo/backend/app/scheduler"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
 	"github.com/kishansakhiya/wails-demo/backend/app/watcher"
 )
 
 // App struct
 type App struct {
 	ctx              context.Context
+	config           *config.Config
 	systemService    *services.SystemService
 	schedulerService *scheduler.SchedulerService
 	watcherService   *watcher.WatcherService
+	historyService   *history.HistoryService
+	processControl   *procctl.ProcessControlService
+	liveBroadcaster  *live.UsageBroadcaster
+	liveSubscription *live.Subscription
+	liveMutex        sync.Mutex
 	db               *database.DB
 	logger           *log.Logger
 }
 
 // NewApp creates a new App application struct
 func NewApp() *App {
+	logger := log.New(os.Stdout, "[APP] ", log.LstdFlags)
+
+	// A bad setting should not keep the desktop app from opening, so fall back to the defaults
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Printf("Invalid configuration, using defaults: %v", err)
+		cfg = config.DefaultConfig()
+	}
+	systemService := services.NewSystemService(cfg)
+
 	return &App{
-		systemService: services.NewSystemService(),
-		logger:        log.New(os.Stdout, "[APP] ", log.LstdFlags),
+		config:          cfg,
+		systemService:   systemService,
+		liveBroadcaster: live.NewUsageBroadcaster(systemService, cfg.Live),
+		logger:          logger,
 	}
 }
 
@@ -37,6 +61,9 @@ func (a *App) Startup(ctx context.Context) {
 	a.ctx = ctx
 	a.logger.Println("Application started")
 
+	// Start background usage sampling so usage calls return immediately
+	go a.systemService.StartSampler(ctx)
+
 	// Initialize database
 	var err error
 	a.db, err = database.NewDB()
@@ -47,24 +74,47 @@ func (a *App) Startup(ctx context.Context) {
 	a.logger.Println("Database initialized successfully")
 
 	// Initialize scheduler service
-	a.schedulerService = scheduler.NewSchedulerService(a.db)
+	a.schedulerService = scheduler.NewSchedulerService(a.db, a.systemService)
 	a.logger.Println("Scheduler service initialized")
 
 	// Initialize and start watcher service
 	a.watcherService = watcher.NewWatcherService(a.schedulerService, 60*time.Second)
 	go a.watcherService.StartWatcher(ctx)
 	a.logger.Println("Watcher service started")
+
+	// Initialize process control, which needs the database for its audit log
+	if a.processControl, err = procctl.NewProcessControlService(a.db, a.config.Host.ProcRoot); err != nil {
+		a.logger.Printf("Process control disabled: %v", err)
+	}
+
+	// Initialize and start usage history sampler
+	if a.config.History.Enabled {
+		a.historyService = history.NewHistoryService(a.systemService, a.db, a.config.History)
+		go a.historyService.StartSampler(ctx)
+		a.logger.Println("Usage history sampler started")
+	}
 }
 
 // shutdown is called at application termination
 func (a *App) Shutdown(ctx context.Context) {
 	a.logger.Println("Application shutting down")
 
+	// Stop live usage events
+	a.StopLiveUsage()
+
 	// Stop watcher service
 	if a.watcherService != nil {
 		a.watcherService.StopWatcher()
 	}
 
+	// Stop background usage sampling
+	a.systemService.StopSampler()
+
+	// Stop usage history sampler
+	if a.historyService != nil {
+		a.historyService.StopSampler()
+	}
+
 	// Close database connection
 	if a.db != nil {
 		if err := a.db.Close(); err != nil {
@@ -80,47 +130,173 @@ func (a *App) DomReady(ctx context.Context) {
 
 // GetAllSystemInfo retrieves all system information
 func (a *App) GetAllSystemInfo() (any, error) {
-	return a.systemService.GetAllSystemInfo()
+	return a.systemService.GetAllSystemInfo(a.ctx, false)
+}
+
+// ListCollectors lists the registered collectors and their TTLs
+func (a *App) ListCollectors() (any, error) {
+	return a.systemService.ListCollectors(), nil
+}
+
+// Collect runs a registered collector by name, e.g. "cpu" or "sensors".
+// The section bindings below go through it too, so each gets its collector's cache and deadline.
+func (a *App) Collect(name string) (any, error) {
+	return a.systemService.Collect(a.ctx, name)
 }
 
 // GetCPUInfo retrieves CPU information
 func (a *App) GetCPUInfo() (any, error) {
-	return a.systemService.GetCPUInfo()
+	return a.systemService.Collect(a.ctx, "cpu")
 }
 
 // GetGPUInfo retrieves GPU information
 func (a *App) GetGPUInfo() (any, error) {
-	return a.systemService.GetGPUInfo()
+	return a.systemService.Collect(a.ctx, "gpus")
 }
 
 // GetOSInfo retrieves OS information
 func (a *App) GetOSInfo() (any, error) {
-	return a.systemService.GetOSInfo()
+	return a.systemService.Collect(a.ctx, "os")
 }
 
 // GetLocationInfo retrieves location information
 func (a *App) GetLocationInfo() (any, error) {
-	return a.systemService.GetLocationInfo()
+	return a.systemService.Collect(a.ctx, "location")
 }
 
 // GetMemoryInfo retrieves memory information
 func (a *App) GetMemoryInfo() (any, error) {
-	return a.systemService.GetMemoryInfo()
+	return a.systemService.Collect(a.ctx, "memory")
 }
 
 // GetDiskInfo retrieves disk information
 func (a *App) GetDiskInfo() (any, error) {
-	return a.systemService.GetDiskInfo()
+	return a.systemService.Collect(a.ctx, "disk")
+}
+
+// GetDiskIO retrieves per-device disk throughput, IOPS, latency, and utilization
+func (a *App) GetDiskIO() (any, error) {
+	return a.systemService.Collect(a.ctx, "disk_io")
+}
+
+// GetDiskPartitions retrieves space and inode usage for each mounted filesystem
+func (a *App) GetDiskPartitions() (any, error) {
+	return a.systemService.Collect(a.ctx, "disk_partitions")
+}
+
+// GetNetworkInterfaces retrieves addresses, link settings, traffic counters, and rates per network interface
+func (a *App) GetNetworkInterfaces() (any, error) {
+	return a.systemService.Collect(a.ctx, "network")
+}
+
+// GetSensors retrieves temperature, fan, and voltage readings
+func (a *App) GetSensors() (any, error) {
+	return a.systemService.Collect(a.ctx, "sensors")
+}
+
+// GetPowerStatus retrieves AC adapter and battery state
+func (a *App) GetPowerStatus() (any, error) {
+	return a.systemService.Collect(a.ctx, "power")
+}
+
+// GetCgroup retrieves the limits and usage of the cgroup the app runs in
+func (a *App) GetCgroup() (any, error) {
+	return a.systemService.Collect(a.ctx, "cgroup")
 }
 
 // GetHardwareInfo retrieves hardware information
 func (a *App) GetHardwareInfo() (any, error) {
-	return a.systemService.GetHardwareInfo()
+	return a.systemService.Collect(a.ctx, "hardware")
+}
+
+// GetPCIDevices retrieves the devices on the PCI bus
+func (a *App) GetPCIDevices() (any, error) {
+	return a.systemService.Collect(a.ctx, "pci")
+}
+
+// GetUSBDevices retrieves the devices on the USB buses
+func (a *App) GetUSBDevices() (any, error) {
+	return a.systemService.Collect(a.ctx, "usb")
 }
 
 // GetUsagePercentages retrieves usage percentages
 func (a *App) GetUsagePercentages() (any, error) {
-	return a.systemService.GetUsagePercentages()
+	return a.systemService.GetUsagePercentages(a.ctx, 0, services.UsageBaseHost)
+}
+
+// GetUsageHistory retrieves usage history between from and to (unix seconds) in buckets of step seconds.
+// Zero values fall back to the last hour at the sampling interval.
+func (a *App) GetUsageHistory(from, to int64, step int) (any, error) {
+	if a.historyService == nil {
+		return nil, fmt.Errorf("history service not initialized")
+	}
+
+	var fromTime, toTime time.Time
+	if from > 0 {
+		fromTime = time.Unix(from, 0)
+	}
+	if to > 0 {
+		toTime = time.Unix(to, 0)
+	}
+	return a.historyService.GetHistory(fromTime, toTime, time.Duration(step)*time.Second)
+}
+
+// GetProcesses retrieves processes sorted by sort (cpu, memory, io, start) in order (asc, desc),
+// filtered by name substring and user, limited to limit entries. Empty values use the defaults.
+func (a *App) GetProcesses(sort, order, name, user string, limit int) (any, error) {
+	return a.systemService.GetProcesses(a.ctx, models.ProcessQuery{
+		Sort:  sort,
+		Order: order,
+		Name:  name,
+		User:  user,
+		Limit: limit,
+	})
+}
+
+// GetProcessDetail retrieves detailed information about a single process
+func (a *App) GetProcessDetail(pid int) (any, error) {
+	return a.systemService.GetProcessDetail(a.ctx, int32(pid))
+}
+
+// ConfirmProcessAction issues a single-use token authorizing action (SIGTERM, SIGKILL, SIGSTOP,
+// SIGCONT or renice) on pid. nice is only used for renice.
+func (a *App) ConfirmProcessAction(pid int, action string, nice int) (any, error) {
+	if a.processControl == nil {
+		return nil, fmt.Errorf("process control not initialized")
+	}
+	return a.processControl.RequestConfirmation(int32(pid), action, nice)
+}
+
+// SignalProcess sends a confirmed signal to a process
+func (a *App) SignalProcess(pid int, signal, token string) (any, error) {
+	if a.processControl == nil {
+		return nil, fmt.Errorf("process control not initialized")
+	}
+	return a.processControl.Signal(int32(pid), signal, token, procctl.SourceDesktop)
+}
+
+// ReniceProcess changes the nice value of a process using a confirmation token
+func (a *App) ReniceProcess(pid int, nice int, token string) (any, error) {
+	if a.processControl == nil {
+		return nil, fmt.Errorf("process control not initialized")
+	}
+	return a.processControl.Renice(int32(pid), nice, token, procctl.SourceDesktop)
+}
+
+// WaitForProcessExit waits up to timeout seconds for a process to exit (0 for the default)
+func (a *App) WaitForProcessExit(pid int, timeout int) (any, error) {
+	if a.processControl == nil {
+		return nil, fmt.Errorf("process control not initialized")
+	}
+	return a.processControl.WaitForExit(a.ctx, int32(pid), time.Duration(timeout)*time.Second, procctl.SourceDesktop)
+}
+
+// GetProcessAudit retrieves the most recent process actions, newest first (0 for the default limit)
+func (a *App) GetProcessAudit(limit int) (any, error) {
+	if a.processControl == nil {
+		return nil, fmt.Errorf("process control not initialized")
+	}
+	return a.processControl.ListAudit(limit)
 }
 
 // Scheduler methods
@@ -177,7 +353,7 @@ func (a *App) SyncWithSystem() error {
 // This method is called when the app is opened via a custom URL scheme (e.g., wails-demo://open)
 func (a *App) OnURL(url string) {
 	a.logger.Printf("Received URL: %s", url)
-	
+
 	// Handle different URL paths
 	if url == "wails-demo://open" {
 		a.logger.Println("Application opened from external source")
@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache is an LRU cache of collector results with a per-entry TTL.
+// Concurrent loads of the same key share one call.
+type Cache struct {
+	mutex   sync.Mutex
+	maxSize int
+	entries map[string]*list.Element
+	order   *list.List // front is most recently used
+	group   singleflight.Group
+}
+
+// entry is a cached value and the time it was loaded
+type entry struct {
+	key      string
+	value    any
+	storedAt time.Time
+	ttl      time.Duration
+}
+
+// Result is a value returned by Fetch together with its freshness
+type Result struct {
+	Value    any
+	StoredAt time.Time
+	TTL      time.Duration
+	Hit      bool
+}
+
+// Age is how long ago the value was loaded
+func (r Result) Age() time.Duration {
+	return time.Since(r.StoredAt)
+}
+
+// MaxAge is how much longer the value stays fresh
+func (r Result) MaxAge() time.Duration {
+	remaining := r.TTL - r.Age()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// New creates a cache holding at most maxSize entries
+func New(maxSize int) *Cache {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &Cache{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Fetch returns the cached value for key while it is younger than ttl, and otherwise calls load
+// and caches its result. With fresh set the cached value is ignored and replaced.
+// Errors are returned to every waiting caller but never cached. A caller whose ctx is done stops
+// waiting without affecting the load, which keeps running for the other callers.
+func (c *Cache) Fetch(ctx context.Context, key string, ttl time.Duration, fresh bool, load func() (any, error)) (Result, error) {
+	if !fresh {
+		if result, ok := c.get(key); ok {
+			return result, nil
+		}
+	}
+
+	results := c.group.DoChan(key, func() (any, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		return c.set(key, value, ttl), nil
+	})
+
+	select {
+	case res := <-results:
+		if res.Err != nil {
+			return Result{}, res.Err
+		}
+		return res.Val.(Result), nil
+	case <-ctx.Done():
+		return Result{}, ctx.Err()
+	}
+}
+
+// Len returns the number of cached entries, including expired ones not yet evicted
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
+
+// get returns a fresh cached value and marks it as recently used
+func (c *Cache) get(key string) (Result, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return Result{}, false
+	}
+	cached := element.Value.(*entry)
+	if time.Since(cached.storedAt) >= cached.ttl {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return Result{}, false
+	}
+
+	c.order.MoveToFront(element)
+	return Result{Value: cached.value, StoredAt: cached.storedAt, TTL: cached.ttl, Hit: true}, true
+}
+
+// set stores a value, evicting the least recently used entries beyond maxSize
+func (c *Cache) set(key string, value any, ttl time.Duration) Result {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached := &entry{key: key, value: value, storedAt: time.Now(), ttl: ttl}
+	if element, ok := c.entries[key]; ok {
+		element.Value = cached
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(cached)
+	}
+
+	for c.order.Len() > c.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+
+	return Result{Value: value, StoredAt: cached.storedAt, TTL: ttl}
+}
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// counter returns a load function that counts its calls and returns the key, and the count
+func counter(key string) (func() (any, error), *atomic.Int32) {
+	calls := &atomic.Int32{}
+	return func() (any, error) {
+		calls.Add(1)
+		return key, nil
+	}, calls
+}
+
+func TestFetchSharesConcurrentLoads(t *testing.T) {
+	c := New(10)
+	started, release := make(chan struct{}), make(chan struct{})
+	var calls atomic.Int32
+	load := func() (any, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return "value", nil
+	}
+
+	const callers = 8
+	results := make([]Result, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	fetch := func(i int) {
+		defer wg.Done()
+		results[i], errs[i] = c.Fetch(context.Background(), "key", time.Minute, false, load)
+	}
+
+	wg.Add(callers)
+	go fetch(0)
+	<-started
+	for i := 1; i < callers; i++ {
+		go fetch(i)
+	}
+	close(release)
+	wg.Wait()
+
+	// Callers arriving after the load finished are served from the cache, so there is one load either way
+	if got := calls.Load(); got != 1 {
+		t.Errorf("load called %d times, want 1", got)
+	}
+	for i := range results {
+		if errs[i] != nil || results[i].Value != "value" {
+			t.Errorf("Fetch() #%d = %+v, %v, want value", i, results[i], errs[i])
+		}
+	}
+}
+
+func TestFetchDoesNotCacheErrors(t *testing.T) {
+	c := New(10)
+	failure := errors.New("failed")
+	calls := 0
+	load := func() (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, failure
+		}
+		return "value", nil
+	}
+
+	if _, err := c.Fetch(context.Background(), "key", time.Minute, false, load); !errors.Is(err, failure) {
+		t.Fatalf("first Fetch() error = %v, want %v", err, failure)
+	}
+	result, err := c.Fetch(context.Background(), "key", time.Minute, false, load)
+	if err != nil || result.Value != "value" || result.Hit {
+		t.Fatalf("second Fetch() = %+v, %v, want a fresh load", result, err)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", c.Len())
+	}
+}
+
+func TestFetchExpiryAndFresh(t *testing.T) {
+	c := New(10)
+	load, calls := counter("value")
+
+	if _, err := c.Fetch(context.Background(), "key", time.Minute, false, load); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if result, _ := c.Fetch(context.Background(), "key", time.Minute, false, load); !result.Hit {
+		t.Errorf("Fetch() within the TTL missed the cache")
+	}
+	if result, _ := c.Fetch(context.Background(), "key", time.Minute, true, load); result.Hit {
+		t.Errorf("Fetch() with fresh set hit the cache")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("load called %d times, want 2", got)
+	}
+
+	// A zero TTL expires the entry immediately
+	if _, err := c.Fetch(context.Background(), "expiring", 0, false, load); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if result, _ := c.Fetch(context.Background(), "expiring", 0, false, load); result.Hit {
+		t.Errorf("Fetch() of an expired entry hit the cache")
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("load called %d times, want 4", got)
+	}
+}
+
+func TestFetchEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New(2)
+	fetch := func(key string) Result {
+		t.Helper()
+		load, _ := counter(key)
+		result, err := c.Fetch(context.Background(), key, time.Minute, false, load)
+		if err != nil {
+			t.Fatalf("Fetch(%q) error = %v", key, err)
+		}
+		return result
+	}
+
+	fetch("a")
+	fetch("b")
+	fetch("a") // a is now more recently used than b
+	fetch("c")
+
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", c.Len())
+	}
+	if !fetch("a").Hit {
+		t.Errorf("a was evicted, want b evicted")
+	}
+	if !fetch("c").Hit {
+		t.Errorf("c was evicted, want b evicted")
+	}
+	if fetch("b").Hit {
+		t.Errorf("b is still cached, want it evicted")
+	}
+}
+
+func TestFetchStopsWaitingWhenContextIsDone(t *testing.T) {
+	c := New(10)
+	release := make(chan struct{})
+	load := func() (any, error) {
+		<-release
+		return "value", nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.Fetch(ctx, "key", time.Minute, false, load); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Fetch() with a done context error = %v, want context.Canceled", err)
+	}
+
+	// The abandoned load keeps running and is shared with the next caller
+	close(release)
+	result, err := c.Fetch(context.Background(), "key", time.Minute, false, load)
+	if err != nil || result.Value != "value" {
+		t.Fatalf("Fetch() = %+v, %v, want value", result, err)
+	}
+}
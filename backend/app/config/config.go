@@ -3,8 +3,11 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
 )
 
 // Config holds all configuration for the application
@@ -13,6 +16,15 @@ type Config struct {
 	Location  LocationConfig
 	Cache     CacheConfig
 	RateLimit RateLimitConfig
+	History   HistoryConfig
+	Metrics   MetricsConfig
+	Live      LiveConfig
+	Disk      DiskConfig
+	Network   NetworkConfig
+	Host      HostConfig
+	Sampler   SamplerConfig
+	Collector CollectorConfig
+	Process   ProcessControlConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -31,8 +43,8 @@ type LocationConfig struct {
 
 // CacheConfig holds cache-related configuration
 type CacheConfig struct {
-	TTL     int
-	MaxSize int
+	TTL     int // seconds, for collectors that do not set their own TTL
+	MaxSize int // maximum number of cached collector results
 	Enabled bool
 }
 
@@ -43,37 +55,188 @@ type RateLimitConfig struct {
 	Window  int
 }
 
-// LoadConfig loads configuration from environment variables
+// HistoryConfig holds usage history sampling configuration
+type HistoryConfig struct {
+	Enabled   bool
+	Interval  int // seconds between samples
+	Retention int // hours to keep samples
+}
+
+// SamplerConfig holds background usage sampling configuration
+type SamplerConfig struct {
+	Interval  int // seconds between samples
+	Retention int // seconds of samples kept for averaging over a window
+}
+
+// CollectorConfig holds how long collectors may run before they are cancelled
+type CollectorConfig struct {
+	Timeout          int            // seconds, for collectors without their own timeout
+	Timeouts         map[string]int // seconds keyed by collector name
+	AggregateTimeout int            // seconds for the whole aggregate system response
+}
+
+// ProcessControlConfig holds whether the REST API may signal and renice processes.
+// Requests must carry Token as a bearer token and come from the API's own origin or one of Origins.
+type ProcessControlConfig struct {
+	Enabled bool
+	Token   string
+	Origins []string
+}
+
+// MetricsConfig holds Prometheus exposition configuration
+type MetricsConfig struct {
+	Enabled bool
+	Path    string
+}
+
+// LiveConfig holds live usage streaming configuration
+type LiveConfig struct {
+	Interval   int // seconds between shared samples
+	MaxClients int
+	Alerts     AlertConfig
+}
+
+// AlertConfig holds usage percentages above which live alerts are raised (0 disables)
+type AlertConfig struct {
+	CPU    float64
+	GPU    float64
+	Memory float64
+	Disk   float64
+}
+
+// DiskConfig holds filters applied to mounted partitions.
+// Fstypes match case-insensitively; mountpoints are glob patterns such as /snap/*.
+// An empty include list includes everything not excluded.
+type DiskConfig struct {
+	IncludeFstypes     []string
+	ExcludeFstypes     []string
+	IncludeMountpoints []string
+	ExcludeMountpoints []string
+}
+
+// NetworkConfig holds case-insensitive glob patterns selecting network interfaces.
+// An empty include list includes everything not excluded.
+type NetworkConfig struct {
+	IncludeInterfaces []string
+	ExcludeInterfaces []string
+}
+
+// HostConfig holds where host filesystems are mounted, so a containerized
+// process can report on its host and readers can be pointed at fixture files
+type HostConfig struct {
+	ProcRoot   string
+	SysRoot    string
+	EtcRoot    string
+	RunRoot    string
+	CgroupRoot string
+}
+
+// LoadConfig loads configuration from environment variables, falling back to the JSON file
+// named by CONFIG_FILE for the settings it supports. It panics when the configuration is invalid.
 func LoadConfig() *Config {
-	config := &Config{
+	config, err := Load()
+	if err != nil {
+		panic(fmt.Sprintf("Invalid configuration: %v", err))
+	}
+	return config
+}
+
+// Load loads and validates configuration like LoadConfig, returning an error instead of panicking
+func Load() (*Config, error) {
+	env := environment(os.LookupEnv)
+	file, err := loadConfigFile(env.getEnv("CONFIG_FILE", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	config := newConfig(env, file)
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// DefaultConfig returns the built-in defaults, ignoring environment variables and the config file
+func DefaultConfig() *Config {
+	return newConfig(environment(func(string) (string, bool) { return "", false }), &fileConfig{})
+}
+
+// newConfig builds a configuration from the variables in env and the settings in file
+func newConfig(env environment, file *fileConfig) *Config {
+	return &Config{
 		Server: ServerConfig{
-			Port: getEnv("PORT", "7000"),
-			Host: getEnv("HOST", "localhost"),
-			Mode: getEnv("GIN_MODE", "release"),
+			Port: env.getEnv("PORT", "7000"),
+			Host: env.getEnv("HOST", "localhost"),
+			Mode: env.getEnv("GIN_MODE", "release"),
 		},
 		Location: LocationConfig{
-			APIURL:  getEnv("LOCATION_API_URL", "https://ipinfo.io/json"),
-			Timeout: getEnvInt("LOCATION_TIMEOUT", 10),
-			Retries: getEnvInt("LOCATION_RETRIES", 3),
+			APIURL:  env.getEnv("LOCATION_API_URL", "https://ipinfo.io/json"),
+			Timeout: env.getEnvInt("LOCATION_TIMEOUT", 10),
+			Retries: env.getEnvInt("LOCATION_RETRIES", 3),
 		},
 		Cache: CacheConfig{
-			TTL:     getEnvInt("CACHE_TTL", 30),
-			MaxSize: getEnvInt("CACHE_MAX_SIZE", 1000),
-			Enabled: getEnvBool("CACHE_ENABLED", true),
+			TTL:     env.getEnvInt("CACHE_TTL", 30),
+			MaxSize: env.getEnvInt("CACHE_MAX_SIZE", 1000),
+			Enabled: env.getEnvBool("CACHE_ENABLED", true),
 		},
 		RateLimit: RateLimitConfig{
-			Enabled: getEnvBool("RATE_LIMIT_ENABLED", true),
-			Limit:   getEnvInt("RATE_LIMIT_LIMIT", 100),
-			Window:  getEnvInt("RATE_LIMIT_WINDOW", 60),
+			Enabled: env.getEnvBool("RATE_LIMIT_ENABLED", true),
+			Limit:   env.getEnvInt("RATE_LIMIT_LIMIT", 100),
+			Window:  env.getEnvInt("RATE_LIMIT_WINDOW", 60),
+		},
+		History: HistoryConfig{
+			Enabled:   env.getEnvBool("HISTORY_ENABLED", true),
+			Interval:  env.getEnvInt("HISTORY_INTERVAL", 60),
+			Retention: env.getEnvInt("HISTORY_RETENTION", 168),
+		},
+		Metrics: MetricsConfig{
+			Enabled: env.getEnvBool("METRICS_ENABLED", true),
+			Path:    env.getEnv("METRICS_PATH", "/metrics"),
+		},
+		Live: LiveConfig{
+			Interval:   env.getEnvInt("LIVE_INTERVAL", 2),
+			MaxClients: env.getEnvInt("LIVE_MAX_CLIENTS", 100),
+			Alerts: AlertConfig{
+				CPU:    env.getEnvFloat("ALERT_CPU_PERCENT", 90),
+				GPU:    env.getEnvFloat("ALERT_GPU_PERCENT", 95),
+				Memory: env.getEnvFloat("ALERT_MEMORY_PERCENT", 90),
+				Disk:   env.getEnvFloat("ALERT_DISK_PERCENT", 95),
+			},
+		},
+		Disk: DiskConfig{
+			IncludeFstypes:     env.getEnvList("DISK_INCLUDE_FSTYPES", nil),
+			ExcludeFstypes:     env.getEnvList("DISK_EXCLUDE_FSTYPES", []string{"tmpfs", "devtmpfs", "devfs", "squashfs"}),
+			IncludeMountpoints: env.getEnvList("DISK_INCLUDE_MOUNTPOINTS", nil),
+			ExcludeMountpoints: env.getEnvList("DISK_EXCLUDE_MOUNTPOINTS", nil),
+		},
+		Network: NetworkConfig{
+			IncludeInterfaces: env.getEnvList("NETWORK_INCLUDE_INTERFACES", nil),
+			ExcludeInterfaces: env.getEnvList("NETWORK_EXCLUDE_INTERFACES", []string{
+				"lo", "lo0", "br-*", "docker*", "veth*", "virbr*", "vnet*",
+			}),
+		},
+		Host: HostConfig{
+			ProcRoot:   env.getEnv("HOST_PROC", fileOr(file.Host.Proc, utils.DefaultProcRoot)),
+			SysRoot:    env.getEnv("HOST_SYS", fileOr(file.Host.Sys, utils.DefaultSysRoot)),
+			EtcRoot:    env.getEnv("HOST_ETC", fileOr(file.Host.Etc, utils.DefaultEtcRoot)),
+			RunRoot:    env.getEnv("HOST_RUN", fileOr(file.Host.Run, utils.DefaultRunRoot)),
+			CgroupRoot: env.getEnv("HOST_CGROUP", fileOr(file.Host.Cgroup, utils.DefaultCgroupRoot)),
+		},
+		Sampler: SamplerConfig{
+			Interval:  env.getEnvInt("SAMPLER_INTERVAL", 1),
+			Retention: env.getEnvInt("SAMPLER_RETENTION", 300),
+		},
+		Collector: CollectorConfig{
+			Timeout:          env.getEnvInt("COLLECTOR_TIMEOUT", 10),
+			Timeouts:         env.getEnvIntMap("COLLECTOR_TIMEOUTS"),
+			AggregateTimeout: env.getEnvInt("COLLECTOR_AGGREGATE_TIMEOUT", 30),
+		},
+		Process: ProcessControlConfig{
+			Enabled: env.getEnvBool("PROCESS_CONTROL_ENABLED", false),
+			Token:   env.getEnv("PROCESS_CONTROL_TOKEN", ""),
+			Origins: env.getEnvList("PROCESS_CONTROL_ORIGINS", nil),
 		},
 	}
-
-	// Validate configuration
-	if err := config.Validate(); err != nil {
-		panic(fmt.Sprintf("Invalid configuration: %v", err))
-	}
-
-	return config
 }
 
 // Validate validates the configuration
@@ -112,6 +275,97 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid rate limit window: %d", c.RateLimit.Window)
 	}
 
+	// Validate history sampling
+	if c.History.Interval < 5 || c.History.Interval > 3600 {
+		return fmt.Errorf("invalid history interval: %d", c.History.Interval)
+	}
+
+	if c.History.Retention < 1 || c.History.Retention > 8760 {
+		return fmt.Errorf("invalid history retention: %d", c.History.Retention)
+	}
+
+	// Validate background sampling
+	if c.Sampler.Interval < 1 || c.Sampler.Interval > 60 {
+		return fmt.Errorf("invalid sampler interval: %d", c.Sampler.Interval)
+	}
+
+	if c.Sampler.Retention < c.Sampler.Interval || c.Sampler.Retention > 3600 {
+		return fmt.Errorf("invalid sampler retention: %d", c.Sampler.Retention)
+	}
+
+	// Validate collector deadlines
+	if c.Collector.Timeout < 1 || c.Collector.Timeout > 300 {
+		return fmt.Errorf("invalid collector timeout: %d", c.Collector.Timeout)
+	}
+
+	for name, timeout := range c.Collector.Timeouts {
+		if timeout < 1 || timeout > 300 {
+			return fmt.Errorf("invalid %s collector timeout: %d", name, timeout)
+		}
+	}
+
+	if c.Collector.AggregateTimeout < 1 || c.Collector.AggregateTimeout > 300 {
+		return fmt.Errorf("invalid collector aggregate timeout: %d", c.Collector.AggregateTimeout)
+	}
+
+	// Validate process control; a guessable token would let any local caller kill processes
+	if c.Process.Enabled && len(c.Process.Token) < 16 {
+		return fmt.Errorf("process control requires a token of at least 16 characters")
+	}
+
+	// Validate host roots
+	for name, root := range map[string]string{
+		"proc":   c.Host.ProcRoot,
+		"sys":    c.Host.SysRoot,
+		"etc":    c.Host.EtcRoot,
+		"run":    c.Host.RunRoot,
+		"cgroup": c.Host.CgroupRoot,
+	} {
+		if !path.IsAbs(root) {
+			return fmt.Errorf("invalid host %s root: %s", name, root)
+		}
+	}
+
+	// Validate metrics path
+	if !strings.HasPrefix(c.Metrics.Path, "/") {
+		return fmt.Errorf("invalid metrics path: %s", c.Metrics.Path)
+	}
+
+	// Validate live streaming
+	if c.Live.Interval < 1 || c.Live.Interval > 60 {
+		return fmt.Errorf("invalid live interval: %d", c.Live.Interval)
+	}
+
+	if c.Live.MaxClients < 1 || c.Live.MaxClients > 10000 {
+		return fmt.Errorf("invalid live max clients: %d", c.Live.MaxClients)
+	}
+
+	// Validate alert thresholds
+	for name, threshold := range map[string]float64{
+		"cpu":    c.Live.Alerts.CPU,
+		"gpu":    c.Live.Alerts.GPU,
+		"memory": c.Live.Alerts.Memory,
+		"disk":   c.Live.Alerts.Disk,
+	} {
+		if threshold < 0 || threshold > 100 {
+			return fmt.Errorf("invalid %s alert threshold: %.1f", name, threshold)
+		}
+	}
+
+	// Validate disk mountpoint patterns
+	for _, pattern := range append(c.Disk.IncludeMountpoints, c.Disk.ExcludeMountpoints...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid disk mountpoint pattern: %s", pattern)
+		}
+	}
+
+	// Validate network interface patterns
+	for _, pattern := range append(c.Network.IncludeInterfaces, c.Network.ExcludeInterfaces...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid network interface pattern: %s", pattern)
+		}
+	}
+
 	return nil
 }
 
@@ -120,17 +374,20 @@ func (c *Config) GetServerAddress() string {
 	return fmt.Sprintf("%s:%s", c.Server.Host, c.Server.Port)
 }
 
+// environment looks up configuration variables, normally os.LookupEnv
+type environment func(key string) (string, bool)
+
 // getEnv gets an environment variable or returns a default value
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
+func (env environment) getEnv(key, defaultValue string) string {
+	if value, _ := env(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
 
 // getEnvInt gets an environment variable as integer or returns a default value
-func getEnvInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
+func (env environment) getEnvInt(key string, defaultValue int) int {
+	if value, _ := env(key); value != "" {
 		if intValue, err := strconv.Atoi(value); err == nil {
 			return intValue
 		}
@@ -138,9 +395,48 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvFloat gets an environment variable as float or returns a default value
+func (env environment) getEnvFloat(key string, defaultValue float64) float64 {
+	if value, _ := env(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList gets a comma-separated environment variable as a list or returns a default value.
+// An empty but set variable yields an empty list, clearing the default.
+func (env environment) getEnvList(key string, defaultValue []string) []string {
+	value, ok := env(key)
+	if !ok {
+		return defaultValue
+	}
+
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvIntMap gets a comma-separated list of name=integer pairs, such as "location=5,gpus=15".
+// Values that are not integers are read as 0 so that validation rejects them.
+func (env environment) getEnvIntMap(key string) map[string]int {
+	values := make(map[string]int)
+	for _, item := range env.getEnvList(key, nil) {
+		name, value, _ := strings.Cut(item, "=")
+		intValue, _ := strconv.Atoi(strings.TrimSpace(value))
+		values[strings.TrimSpace(name)] = intValue
+	}
+	return values
+}
+
 // getEnvBool gets an environment variable as boolean or returns a default value
-func getEnvBool(key string, defaultValue bool) bool {
-	if value := os.Getenv(key); value != "" {
+func (env environment) getEnvBool(key string, defaultValue bool) bool {
+	if value, _ := env(key); value != "" {
 		value = strings.ToLower(value)
 		return value == "true" || value == "1" || value == "yes"
 	}
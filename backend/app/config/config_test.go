@@ -0,0 +1,131 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadHostRoots(t *testing.T) {
+	defaults := HostConfig{ProcRoot: "/proc", SysRoot: "/sys", EtcRoot: "/etc", RunRoot: "/run", CgroupRoot: "/sys/fs/cgroup"}
+
+	tests := []struct {
+		name    string
+		file    string // config file contents; no file when empty
+		env     map[string]string
+		want    HostConfig
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			want: defaults,
+		},
+		{
+			name: "config file",
+			file: `{"host": {"proc": "/host/proc", "sys": "/host/sys", "etc": "/host/etc", "run": "/host/run", "cgroup": "/host/cgroup"}}`,
+			want: HostConfig{ProcRoot: "/host/proc", SysRoot: "/host/sys", EtcRoot: "/host/etc", RunRoot: "/host/run", CgroupRoot: "/host/cgroup"},
+		},
+		{
+			name: "environment overrides the config file",
+			file: `{"host": {"proc": "/host/proc", "etc": "/host/etc"}}`,
+			env:  map[string]string{"HOST_PROC": "/mnt/proc", "HOST_SYS": "/mnt/sys"},
+			want: HostConfig{ProcRoot: "/mnt/proc", SysRoot: "/mnt/sys", EtcRoot: "/host/etc", RunRoot: "/run", CgroupRoot: "/sys/fs/cgroup"},
+		},
+		{
+			name:    "relative root",
+			env:     map[string]string{"HOST_ETC": "host/etc"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed config file",
+			file:    `{"host": {"proc": }}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"CONFIG_FILE", "HOST_PROC", "HOST_SYS", "HOST_ETC", "HOST_RUN", "HOST_CGROUP"} {
+				t.Setenv(key, tt.env[key])
+			}
+			if tt.file != "" {
+				path := filepath.Join(t.TempDir(), "config.json")
+				if err := os.WriteFile(path, []byte(tt.file), 0644); err != nil {
+					t.Fatal(err)
+				}
+				t.Setenv("CONFIG_FILE", path)
+			}
+
+			config, err := Load()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && config.Host != tt.want {
+				t.Errorf("Load() host = %+v, want %+v", config.Host, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadMissingConfigFile(t *testing.T) {
+	t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "missing.json"))
+	if _, err := Load(); err == nil {
+		t.Fatal("Load() with a missing config file succeeded, want an error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(c *Config) {}},
+		{name: "port out of range", modify: func(c *Config) { c.Server.Port = "70000" }, wantErr: true},
+		{name: "sampler retention shorter than interval", modify: func(c *Config) { c.Sampler.Interval, c.Sampler.Retention = 10, 5 }, wantErr: true},
+		{name: "collector timeout", modify: func(c *Config) { c.Collector.Timeouts = map[string]int{"location": 5} }},
+		{name: "collector timeout out of range", modify: func(c *Config) { c.Collector.Timeouts = map[string]int{"location": 0} }, wantErr: true},
+		{name: "process control with a token", modify: func(c *Config) { c.Process.Enabled, c.Process.Token = true, "0123456789abcdef" }},
+		{name: "process control with a short token", modify: func(c *Config) { c.Process.Enabled, c.Process.Token = true, "secret" }, wantErr: true},
+		{name: "alert threshold above 100", modify: func(c *Config) { c.Live.Alerts.GPU = 101 }, wantErr: true},
+		{name: "malformed mountpoint pattern", modify: func(c *Config) { c.Disk.ExcludeMountpoints = []string{"/snap/["} }, wantErr: true},
+		{name: "metrics path without a slash", modify: func(c *Config) { c.Metrics.Path = "metrics" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tt.modify(config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnvironmentLists(t *testing.T) {
+	env := environment(func(key string) (string, bool) {
+		value, ok := map[string]string{
+			"EMPTY":    "",
+			"LIST":     " eth*, ,wlan0 ",
+			"TIMEOUTS": "location=5, gpus = 15,disk=slow",
+		}[key]
+		return value, ok
+	})
+
+	if got := env.getEnvList("UNSET", []string{"lo"}); !reflect.DeepEqual(got, []string{"lo"}) {
+		t.Errorf("getEnvList(UNSET) = %v, want the default", got)
+	}
+	if got := env.getEnvList("EMPTY", []string{"lo"}); len(got) != 0 {
+		t.Errorf("getEnvList(EMPTY) = %v, want an empty list", got)
+	}
+	if got := env.getEnvList("LIST", nil); !reflect.DeepEqual(got, []string{"eth*", "wlan0"}) {
+		t.Errorf("getEnvList(LIST) = %v, want [eth* wlan0]", got)
+	}
+	// Values that are not integers read as 0 so that validation rejects them
+	want := map[string]int{"location": 5, "gpus": 15, "disk": 0}
+	if got := env.getEnvIntMap("TIMEOUTS"); !reflect.DeepEqual(got, want) {
+		t.Errorf("getEnvIntMap(TIMEOUTS) = %v, want %v", got, want)
+	}
+}
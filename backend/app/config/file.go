@@ -0,0 +1,45 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// fileConfig holds the settings that can be read from the JSON file named by CONFIG_FILE.
+// Environment variables take precedence over the file.
+type fileConfig struct {
+	Host struct {
+		Proc   string `json:"proc"`
+		Sys    string `json:"sys"`
+		Etc    string `json:"etc"`
+		Run    string `json:"run"`
+		Cgroup string `json:"cgroup"`
+	} `json:"host"`
+}
+
+// loadConfigFile reads the config file at path, returning empty settings when path is empty
+func loadConfigFile(path string) (*fileConfig, error) {
+	file := &fileConfig{}
+	if path == "" {
+		return file, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// fileOr returns a value from the config file, or defaultValue when the file does not set it
+func fileOr(value, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+	return defaultValue
+}
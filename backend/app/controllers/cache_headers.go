@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// wantsFresh reports whether the request asked to bypass cached results with ?fresh=1
+func wantsFresh(ctx *gin.Context) bool {
+	fresh, _ := strconv.ParseBool(ctx.Query("fresh"))
+	return fresh
+}
+
+// setCacheHeaders sets Age to how old the data is and Cache-Control to how much longer clients may reuse it
+func setCacheHeaders(ctx *gin.Context, age, maxAge time.Duration) {
+	if maxAge < time.Second {
+		ctx.Header("Cache-Control", "no-cache")
+	} else {
+		ctx.Header("Cache-Control", fmt.Sprintf("max-age=%d", int(maxAge.Seconds())))
+	}
+	ctx.Header("Age", strconv.Itoa(int(age.Seconds())))
+}
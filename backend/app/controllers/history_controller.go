@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/history"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HistoryController handles HTTP requests for usage history
+type HistoryController struct {
+	historyService *history.HistoryService
+}
+
+// NewHistoryController creates a new instance of HistoryController
+func NewHistoryController(historyService *history.HistoryService) *HistoryController {
+	return &HistoryController{
+		historyService: historyService,
+	}
+}
+
+// GetUsageHistory handles GET request for usage history
+// @Summary Get usage history
+// @Description Retrieve CPU, GPU, memory, and disk usage averaged into buckets over a time range
+// @Tags usage
+// @Accept json
+// @Produce json
+// @Param from query string false "Range start as RFC3339 or unix seconds (default: one hour before to)"
+// @Param to query string false "Range end as RFC3339 or unix seconds (default: now)"
+// @Param step query string false "Bucket size as a duration (e.g. 5m) or seconds (default: sampling interval)"
+// @Success 200 {object} models.UsageHistory
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/usage/history [get]
+func (c *HistoryController) GetUsageHistory(ctx *gin.Context) {
+	from, err := parseTimeParam(ctx.Query("from"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid from parameter", err)
+		return
+	}
+
+	to, err := parseTimeParam(ctx.Query("to"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid to parameter", err)
+		return
+	}
+
+	step, err := parseDurationParam(ctx.Query("step"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid step parameter", err)
+		return
+	}
+
+	data, err := c.historyService.GetHistory(from, to, step)
+	if err != nil {
+		if errors.Is(err, history.ErrInvalidRange) {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid history range", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get usage history", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// sendErrorResponse sends a standardized error response
+func (c *HistoryController) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
+	errorResponse := models.ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	ctx.JSON(statusCode, errorResponse)
+}
+
+// parseTimeParam parses an RFC3339 timestamp or unix seconds, returning the zero time for an empty value
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected RFC3339 timestamp or unix seconds, got %q", value)
+	}
+	return t, nil
+}
+
+// parseDurationParam parses a Go duration or a number of seconds, returning zero for an empty value
+func parseDurationParam(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("expected duration (e.g. 5m) or seconds, got %q", value)
+	}
+	return d, nil
+}
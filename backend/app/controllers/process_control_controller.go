@@ -0,0 +1,241 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/procctl"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProcessControlController handles HTTP requests that act on processes
+type ProcessControlController struct {
+	controlService *procctl.ProcessControlService
+}
+
+// NewProcessControlController creates a new instance of ProcessControlController
+func NewProcessControlController(controlService *procctl.ProcessControlService) *ProcessControlController {
+	return &ProcessControlController{
+		controlService: controlService,
+	}
+}
+
+// ConfirmAction handles POST request for a confirmation token
+// @Summary Request a confirmation token
+// @Description Issue a single-use token, valid for one minute, that authorizes one signal or renice on one process
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param pid path int true "Process ID"
+// @Param request body models.ProcessConfirmationRequest true "Action to confirm"
+// @Success 200 {object} models.ProcessConfirmation
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/{pid}/confirm [post]
+func (c *ProcessControlController) ConfirmAction(ctx *gin.Context) {
+	pid, ok := c.parsePID(ctx)
+	if !ok {
+		return
+	}
+
+	var request models.ProcessConfirmationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	data, err := c.controlService.RequestConfirmation(pid, request.Action, request.Nice)
+	if err != nil {
+		c.sendActionError(ctx, "Failed to confirm process action", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// SignalProcess handles POST request to signal a process
+// @Summary Signal a process
+// @Description Send SIGTERM, SIGKILL, SIGSTOP or SIGCONT to a process. Requires a token from the confirm endpoint. PID 1, kernel threads and the API itself are refused.
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param pid path int true "Process ID"
+// @Param request body models.ProcessSignalRequest true "Signal and confirmation token"
+// @Success 200 {object} models.ProcessActionResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/{pid}/signal [post]
+func (c *ProcessControlController) SignalProcess(ctx *gin.Context) {
+	pid, ok := c.parsePID(ctx)
+	if !ok {
+		return
+	}
+
+	var request models.ProcessSignalRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	data, err := c.controlService.Signal(pid, request.Signal, request.Token, procctl.SourceAPI)
+	if err != nil {
+		c.sendActionError(ctx, "Failed to signal process", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// ReniceProcess handles POST request to change the priority of a process
+// @Summary Renice a process
+// @Description Change the nice value of a process (priority class on Windows). Requires a token from the confirm endpoint.
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param pid path int true "Process ID"
+// @Param request body models.ProcessReniceRequest true "Nice value and confirmation token"
+// @Success 200 {object} models.ProcessActionResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/{pid}/renice [post]
+func (c *ProcessControlController) ReniceProcess(ctx *gin.Context) {
+	pid, ok := c.parsePID(ctx)
+	if !ok {
+		return
+	}
+
+	var request models.ProcessReniceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid request body", err)
+		return
+	}
+
+	data, err := c.controlService.Renice(pid, request.Nice, request.Token, procctl.SourceAPI)
+	if err != nil {
+		c.sendActionError(ctx, "Failed to renice process", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// WaitForExit handles POST request to wait for a process to exit
+// @Summary Wait for a process to exit
+// @Description Block until the process exits or the timeout elapses
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param pid path int true "Process ID"
+// @Param timeout query string false "Go duration (e.g. 5s) or seconds (default: 10s, max: 1m)"
+// @Success 200 {object} models.ProcessWaitResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/{pid}/wait [post]
+func (c *ProcessControlController) WaitForExit(ctx *gin.Context) {
+	pid, ok := c.parsePID(ctx)
+	if !ok {
+		return
+	}
+
+	timeout, err := parseDurationParam(ctx.Query("timeout"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid timeout parameter", err)
+		return
+	}
+
+	data, err := c.controlService.WaitForExit(ctx.Request.Context(), pid, timeout, procctl.SourceAPI)
+	if err != nil {
+		c.sendActionError(ctx, "Failed to wait for process", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// ListAudit handles GET request for the process action audit log
+// @Summary List process actions
+// @Description Retrieve attempted process actions, newest first, including refused and failed attempts
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "Maximum number of entries (default: 100)"
+// @Success 200 {array} database.ProcessAudit
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/audit [get]
+func (c *ProcessControlController) ListAudit(ctx *gin.Context) {
+	limit := 0
+	if value := ctx.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid limit parameter", err)
+			return
+		}
+		limit = parsed
+	}
+
+	data, err := c.controlService.ListAudit(limit)
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to list process actions", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// parsePID reads the pid path parameter, responding with 400 when it is invalid
+func (c *ProcessControlController) parsePID(ctx *gin.Context) (int32, bool) {
+	pid, err := strconv.ParseInt(ctx.Param("pid"), 10, 32)
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid process ID", err)
+		return 0, false
+	}
+	return int32(pid), true
+}
+
+// sendActionError maps process control errors to status codes
+func (c *ProcessControlController) sendActionError(ctx *gin.Context, message string, err error) {
+	switch {
+	case errors.Is(err, procctl.ErrInvalidAction):
+		c.sendErrorResponse(ctx, http.StatusBadRequest, message, err)
+	case errors.Is(err, procctl.ErrProtectedProcess), errors.Is(err, procctl.ErrInvalidConfirmation):
+		c.sendErrorResponse(ctx, http.StatusForbidden, message, err)
+	case errors.Is(err, services.ErrProcessNotFound):
+		c.sendErrorResponse(ctx, http.StatusNotFound, message, err)
+	default:
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, message, err)
+	}
+}
+
+// sendErrorResponse sends a standardized error response
+func (c *ProcessControlController) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
+	errorResponse := models.ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	ctx.JSON(statusCode, errorResponse)
+}
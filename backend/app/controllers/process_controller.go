@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProcessController handles HTTP requests for process information
+type ProcessController struct {
+	systemService *services.SystemService
+}
+
+// NewProcessController creates a new instance of ProcessController
+func NewProcessController(systemService *services.SystemService) *ProcessController {
+	return &ProcessController{
+		systemService: systemService,
+	}
+}
+
+// ListProcesses handles GET request for the process list
+// @Summary List processes
+// @Description Retrieve running processes sorted by CPU, memory, I/O, or start time, optionally filtered by name and user
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Param sort query string false "Sort key: cpu, memory, io, or start (default: cpu)"
+// @Param order query string false "Sort order: asc or desc (default: desc)"
+// @Param name query string false "Case-insensitive substring of the process name"
+// @Param user query string false "Username of the process owner"
+// @Param limit query int false "Maximum number of processes to return (default: 25, max: 1000)"
+// @Success 200 {object} models.ProcessList
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes [get]
+func (c *ProcessController) ListProcesses(ctx *gin.Context) {
+	query := models.ProcessQuery{
+		Sort:  ctx.Query("sort"),
+		Order: ctx.Query("order"),
+		Name:  ctx.Query("name"),
+		User:  ctx.Query("user"),
+	}
+
+	if limit := ctx.Query("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid limit parameter", err)
+			return
+		}
+		query.Limit = value
+	}
+
+	data, err := c.systemService.GetProcesses(ctx.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidProcessQuery) {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid process query", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to list processes", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// GetProcess handles GET request for a single process
+// @Summary Get process details
+// @Description Retrieve command line, open file count, threads, children, and connections of a process
+// @Tags processes
+// @Accept json
+// @Produce json
+// @Param pid path int true "Process ID"
+// @Success 200 {object} models.ProcessDetail
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/processes/{pid} [get]
+func (c *ProcessController) GetProcess(ctx *gin.Context) {
+	pid, err := strconv.ParseInt(ctx.Param("pid"), 10, 32)
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid process ID", err)
+		return
+	}
+
+	data, err := c.systemService.GetProcessDetail(ctx.Request.Context(), int32(pid))
+	if err != nil {
+		if errors.Is(err, services.ErrProcessNotFound) {
+			c.sendErrorResponse(ctx, http.StatusNotFound, "Process not found", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get process information", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, data)
+}
+
+// sendErrorResponse sends a standardized error response
+func (c *ProcessController) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
+	errorResponse := models.ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	ctx.JSON(statusCode, errorResponse)
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/live"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeat is how often a comment is sent to keep idle connections open through proxies
+const streamHeartbeat = 15 * time.Second
+
+// StreamController handles Server-Sent Events streams of live usage
+type StreamController struct {
+	broadcaster *live.UsageBroadcaster
+	maxClients  int
+}
+
+// NewStreamController creates a new instance of StreamController
+func NewStreamController(broadcaster *live.UsageBroadcaster, maxClients int) *StreamController {
+	return &StreamController{
+		broadcaster: broadcaster,
+		maxClients:  maxClients,
+	}
+}
+
+// StreamUsage handles GET request for a live usage stream
+// @Summary Stream usage
+// @Description Stream CPU, GPU, memory, and disk usage as Server-Sent Events ("usage" events). All clients share a single sampler.
+// @Tags usage
+// @Produce text/event-stream
+// @Param interval query string false "Delivery interval as a duration (e.g. 5s) or seconds (default and minimum: sampling interval)"
+// @Success 200 {object} models.UsageSample
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 503 {object} models.ErrorResponse
+// @Router /api/v1/usage/stream [get]
+func (c *StreamController) StreamUsage(ctx *gin.Context) {
+	interval, err := parseDurationParam(ctx.Query("interval"))
+	if err != nil || interval < 0 {
+		if err == nil {
+			err = fmt.Errorf("interval cannot be negative")
+		}
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid interval parameter", err)
+		return
+	}
+
+	if c.broadcaster.Subscribers() >= c.maxClients {
+		c.sendErrorResponse(ctx, http.StatusServiceUnavailable, "Too many live usage streams", fmt.Errorf("limit of %d concurrent streams reached", c.maxClients))
+		return
+	}
+
+	sub := c.broadcaster.Subscribe(interval)
+	defer c.broadcaster.Unsubscribe(sub)
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	clientGone := ctx.Request.Context().Done()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-clientGone:
+			return false
+		case sample, ok := <-sub.C:
+			if !ok {
+				return false
+			}
+			ctx.SSEvent("usage", sample)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+}
+
+// sendErrorResponse sends a standardized error response
+func (c *StreamController) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
+	errorResponse := models.ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	ctx.JSON(statusCode, errorResponse)
+}
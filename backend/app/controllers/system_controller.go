@@ -1,10 +1,9 @@
 package controllers
 
 import (
-	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/kishansakhiya/wails-demo/backend/app/models"
 	"github.com/kishansakhiya/wails-demo/backend/app/services"
@@ -19,55 +18,92 @@ type SystemController struct {
 }
 
 // NewSystemController creates a new instance of SystemController
-func NewSystemController() *SystemController {
+func NewSystemController(systemService *services.SystemService) *SystemController {
 	return &SystemController{
-		systemService: services.NewSystemService(),
+		systemService: systemService,
 	}
 }
 
 // GetAllSystemInfo handles GET request for all system information
 // @Summary Get all system information
-// @Description Retrieve comprehensive system information including CPU, GPU, memory, disk, and hardware details
+// @Description Retrieve comprehensive system information including CPU, GPU, memory, disk, and hardware details.
+// @Description Sections that fail are left out and described in errors; status is complete (200), partial (207), or failed (500).
 // @Tags system
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.SystemInfo
-// @Failure 500 {object} models.ErrorResponse
+// @Success 207 {object} models.SystemInfo
+// @Failure 500 {object} models.SystemInfo
 // @Router /api/v1/system [get]
 func (c *SystemController) GetAllSystemInfo(ctx *gin.Context) {
-	// Create context with timeout
-	reqCtx, cancel := context.WithTimeout(ctx.Request.Context(), 30*time.Second)
-	defer cancel()
+	// Failed sections are described in the response itself, so it is sent whatever the status.
+	// The service bounds the whole response by the configured aggregate timeout.
+	data, _ := c.systemService.GetAllSystemInfo(ctx.Request.Context(), wantsFresh(ctx))
+
+	status := http.StatusOK
+	switch data.Status {
+	case models.SystemStatusPartial:
+		status = http.StatusMultiStatus
+	case models.SystemStatusFailed:
+		status = http.StatusInternalServerError
+	}
+
+	setCacheHeaders(ctx, data.Age, data.MaxAge)
+	ctx.JSON(status, data)
+}
 
-	// Add timeout to gin context
-	ctx.Request = ctx.Request.WithContext(reqCtx)
+// ListCollectors handles GET request for the registered collectors
+// @Summary List collectors
+// @Description List every registered collector with how long its results stay fresh
+// @Tags collectors
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.CollectorInfo
+// @Router /api/v1/collectors [get]
+func (c *SystemController) ListCollectors(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, c.systemService.ListCollectors())
+}
 
-	data, err := c.systemService.GetAllSystemInfo()
+// Collect handles GET request for a single collector
+// @Summary Run a collector
+// @Description Run one registered collector by name and return its data, e.g. cpu, memory, sensors
+// @Tags collectors
+// @Accept json
+// @Produce json
+// @Param name path string true "Collector name"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} object
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/collectors/{name} [get]
+func (c *SystemController) Collect(ctx *gin.Context) {
+	result, err := c.systemService.CollectResult(ctx.Request.Context(), ctx.Param("name"), wantsFresh(ctx))
 	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get system information", err)
+		if errors.Is(err, services.ErrCollectorNotFound) {
+			c.sendErrorResponse(ctx, http.StatusNotFound, "Collector not found", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to run collector", err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, data)
+	setCacheHeaders(ctx, result.Age(), result.MaxAge())
+	ctx.JSON(http.StatusOK, result.Value)
 }
 
 // GetCPUInfo handles GET request for CPU information
 // @Summary Get CPU information
-// @Description Retrieve detailed CPU information including cores, frequency, and usage
+// @Description Retrieve detailed CPU information including core and socket counts, frequency, per-core usage, time breakdown, load averages, and context switch/interrupt rates
 // @Tags cpu
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.CPUInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/cpu [get]
 func (c *SystemController) GetCPUInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetCPUInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get CPU information", err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, data)
+	c.sendCollected(ctx, "cpu", "Failed to get CPU information")
 }
 
 // GetGPUInfo handles GET request for GPU information
@@ -76,17 +112,12 @@ func (c *SystemController) GetCPUInfo(ctx *gin.Context) {
 // @Tags gpu
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.GPUInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/gpu [get]
 func (c *SystemController) GetGPUInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetGPUInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get GPU information", err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, data)
+	c.sendCollected(ctx, "gpus", "Failed to get GPU information")
 }
 
 // GetOSInfo handles GET request for OS information
@@ -95,17 +126,12 @@ func (c *SystemController) GetGPUInfo(ctx *gin.Context) {
 // @Tags os
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.OSInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/os [get]
 func (c *SystemController) GetOSInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetOSInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get OS information", err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, data)
+	c.sendCollected(ctx, "os", "Failed to get OS information")
 }
 
 // GetLocationInfo handles GET request for location information
@@ -114,88 +140,197 @@ func (c *SystemController) GetOSInfo(ctx *gin.Context) {
 // @Tags location
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.LocationInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/location [get]
 func (c *SystemController) GetLocationInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetLocationInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get location information", err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, data)
+	c.sendCollected(ctx, "location", "Failed to get location information")
 }
 
 // GetMemoryInfo handles GET request for memory information
 // @Summary Get memory information
-// @Description Retrieve memory information including total, used, and available memory
+// @Description Retrieve memory information including totals, buffers/cache/shared/slab, swap usage, swap and major page fault rates, and Linux pressure stall information
 // @Tags memory
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.MemoryInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/memory [get]
 func (c *SystemController) GetMemoryInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetMemoryInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get memory information", err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, data)
+	c.sendCollected(ctx, "memory", "Failed to get memory information")
 }
 
 // GetDiskInfo handles GET request for disk information
 // @Summary Get disk information
-// @Description Retrieve disk information including partitions, usage, and I/O statistics
+// @Description Retrieve total, used, and free space summed across filtered partitions, counting each device once
 // @Tags disk
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.DiskInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/disk [get]
 func (c *SystemController) GetDiskInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetDiskInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get disk information", err)
-		return
-	}
+	c.sendCollected(ctx, "disk", "Failed to get disk information")
+}
 
-	ctx.JSON(http.StatusOK, data)
+// GetDiskPartitions handles GET request for per-partition disk information
+// @Summary Get disk partitions
+// @Description Retrieve device, mountpoint, fstype, mount options, space, and inode usage for each mounted filesystem that passes the configured filters
+// @Tags disk
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {array} models.DiskPartition
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/disk/partitions [get]
+func (c *SystemController) GetDiskPartitions(ctx *gin.Context) {
+	c.sendCollected(ctx, "disk_partitions", "Failed to get disk partitions")
+}
+
+// GetDiskIO handles GET request for disk I/O statistics
+// @Summary Get disk I/O statistics
+// @Description Retrieve read/write throughput, IOPS, average latency, and utilization per block device since the previous request (at least one second apart)
+// @Tags disk
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.DiskIO
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/disk/io [get]
+func (c *SystemController) GetDiskIO(ctx *gin.Context) {
+	c.sendCollected(ctx, "disk_io", "Failed to get disk I/O statistics")
+}
+
+// GetNetworkInterfaces handles GET request for network interface information
+// @Summary Get network interfaces
+// @Description Retrieve flags, IPv4/IPv6 addresses, MTU, link speed and duplex, traffic counters, and per-second rates for each interface that passes the configured filters
+// @Tags network
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {array} models.NetworkInterface
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/network [get]
+func (c *SystemController) GetNetworkInterfaces(ctx *gin.Context) {
+	c.sendCollected(ctx, "network", "Failed to get network interfaces")
+}
+
+// GetSensors handles GET request for hardware sensor readings
+// @Summary Get sensor readings
+// @Description Retrieve temperatures with the high and critical thresholds reported by the kernel, plus fan speeds and voltages from Linux hwmon
+// @Tags sensors
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.Sensors
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/sensors [get]
+func (c *SystemController) GetSensors(ctx *gin.Context) {
+	c.sendCollected(ctx, "sensors", "Failed to get sensor readings")
+}
+
+// GetPowerStatus handles GET request for AC adapter and battery state
+// @Summary Get power supply status
+// @Description Retrieve AC adapter state and per-battery charge, rate, health, cycle count, and estimated time remaining from Linux power_supply
+// @Tags power
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.PowerStatus
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/power [get]
+func (c *SystemController) GetPowerStatus(ctx *gin.Context) {
+	c.sendCollected(ctx, "power", "Failed to get power status")
+}
+
+// GetCgroup handles GET request for the limits and usage of the process's cgroup
+// @Summary Get cgroup limits
+// @Description Retrieve the CPU quota and period, effective CPU count, throttling, memory limit, usage and peak, OOM events, and pids limit of the cgroup the API runs in (Linux cgroup v1 or v2)
+// @Tags cgroup
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.Cgroup
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/cgroup [get]
+func (c *SystemController) GetCgroup(ctx *gin.Context) {
+	c.sendCollected(ctx, "cgroup", "Failed to get cgroup limits")
 }
 
 // GetHardwareInfo handles GET request for hardware information
 // @Summary Get hardware information
-// @Description Retrieve hardware information including motherboard, BIOS, and device details
+// @Description Retrieve the system vendor and product, motherboard, BIOS, and chassis type from DMI/SMBIOS. Network interfaces are served by /api/v1/network.
 // @Tags hardware
 // @Accept json
 // @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
 // @Success 200 {object} models.HardwareInfo
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/hardware [get]
 func (c *SystemController) GetHardwareInfo(ctx *gin.Context) {
-	data, err := c.systemService.GetHardwareInfo()
-	if err != nil {
-		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get hardware information", err)
-		return
-	}
+	c.sendCollected(ctx, "hardware", "Failed to get hardware information")
+}
 
-	ctx.JSON(http.StatusOK, data)
+// GetPCIDevices handles GET request for the devices on the PCI bus
+// @Summary Get PCI devices
+// @Description Retrieve the devices on the PCI bus with vendor, device, and subsystem IDs, best-effort names from the bundled subset of pci.ids, class, revision, and bound driver (Linux only). The subset lists common vendors and devices only, so names may be empty; rely on the IDs
+// @Tags hardware
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {array} models.PCIDevice
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/hardware/pci [get]
+func (c *SystemController) GetPCIDevices(ctx *gin.Context) {
+	c.sendCollected(ctx, "pci", "Failed to get PCI devices")
+}
+
+// GetUSBDevices handles GET request for the devices on the USB buses
+// @Summary Get USB devices
+// @Description Retrieve the devices on the USB buses, root hubs included, with vendor and product IDs, best-effort names from the bundled subset of usb.ids, class, speed, port path, and bound drivers (Linux only). The subset lists common vendors and products only, so names may be empty; rely on the IDs
+// @Tags hardware
+// @Accept json
+// @Produce json
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {array} models.USBDevice
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/hardware/usb [get]
+func (c *SystemController) GetUSBDevices(ctx *gin.Context) {
+	c.sendCollected(ctx, "usb", "Failed to get USB devices")
 }
 
 // GetUsagePercentages handles GET request for usage percentages
 // @Summary Get usage percentages
-// @Description Retrieve usage percentages for CPU, GPU, memory, and disk
+// @Description Retrieve usage percentages for CPU, GPU, memory, and disk from the background sampler, optionally averaged over a window
 // @Tags usage
 // @Accept json
 // @Produce json
+// @Param window query string false "Average over this duration, e.g. 10s or 1m (default: latest sample)"
+// @Param relative_to query string false "host, or cgroup for CPU and memory relative to the process's cgroup limits (default: host)"
 // @Success 200 {object} models.UsagePercentages
+// @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/usage [get]
 func (c *SystemController) GetUsagePercentages(ctx *gin.Context) {
-	data, err := c.systemService.GetUsagePercentages()
+	window, err := parseDurationParam(ctx.Query("window"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid window", err)
+		return
+	}
+
+	data, err := c.systemService.GetUsagePercentages(ctx.Request.Context(), window, ctx.Query("relative_to"))
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidWindow) {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid window", err)
+			return
+		}
+		if errors.Is(err, services.ErrInvalidUsageBase) {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid relative_to", err)
+			return
+		}
 		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get usage percentages", err)
 		return
 	}
@@ -268,6 +403,18 @@ func (c *SystemController) TestRoute(ctx *gin.Context) {
 	})
 }
 
+// sendCollected runs the named collector, served from the cache when fresh, and sends its data with cache headers
+func (c *SystemController) sendCollected(ctx *gin.Context, name, message string) {
+	result, err := c.systemService.CollectResult(ctx.Request.Context(), name, wantsFresh(ctx))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, message, err)
+		return
+	}
+
+	setCacheHeaders(ctx, result.Age(), result.MaxAge())
+	ctx.JSON(http.StatusOK, result.Value)
+}
+
 // sendErrorResponse sends a standardized error response
 func (c *SystemController) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
 	errorResponse := models.ErrorResponse{
@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SystemV2Controller handles /api/v2 requests, which return numeric values instead of formatted strings
+type SystemV2Controller struct {
+	systemService *services.SystemService
+}
+
+// NewSystemV2Controller creates a new instance of SystemV2Controller
+func NewSystemV2Controller(systemService *services.SystemService) *SystemV2Controller {
+	return &SystemV2Controller{
+		systemService: systemService,
+	}
+}
+
+// GetAllSystemInfo handles GET request for all system information with numeric values
+// @Summary Get all system information (v2)
+// @Description Same sections, status, and errors as /api/v1/system, with CPU, GPU, memory, and disk as numeric v2 models. Use ?format=human for formatted values.
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.SystemInfo
+// @Success 207 {object} models.SystemInfo
+// @Failure 500 {object} models.SystemInfo
+// @Router /api/v2/system [get]
+func (c *SystemV2Controller) GetAllSystemInfo(ctx *gin.Context) {
+	// Failed sections are described in the response itself, so it is sent whatever the status
+	data, _ := c.systemService.GetAllSystemInfoV2(ctx.Request.Context(), wantsFresh(ctx))
+
+	status := http.StatusOK
+	switch data.Status {
+	case models.SystemStatusPartial:
+		status = http.StatusMultiStatus
+	case models.SystemStatusFailed:
+		status = http.StatusInternalServerError
+	}
+
+	setCacheHeaders(ctx, data.Age, data.MaxAge)
+	c.respond(ctx, status, data)
+}
+
+// GetCPUStats handles GET request for CPU information with numeric values
+// @Summary Get CPU information (v2)
+// @Description Retrieve CPU topology, cache size in bytes, frequency in MHz, and usage percentages
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.CPUV2
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/cpu [get]
+func (c *SystemV2Controller) GetCPUStats(ctx *gin.Context) {
+	c.sendCollected(ctx, "cpu", "Failed to get CPU information")
+}
+
+// GetGPUStats handles GET request for GPU information with numeric values
+// @Summary Get GPU information (v2)
+// @Description Retrieve GPUs with video memory in bytes, usage percentage, and clock in MHz
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {array} models.GPUV2
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/gpu [get]
+func (c *SystemV2Controller) GetGPUStats(ctx *gin.Context) {
+	c.sendCollected(ctx, "gpus", "Failed to get GPU information")
+}
+
+// GetMemoryStats handles GET request for memory information with numeric values
+// @Summary Get memory information (v2)
+// @Description Retrieve memory and swap sizes in bytes, usage percentages, paging rates, and pressure
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.MemoryV2
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/memory [get]
+func (c *SystemV2Controller) GetMemoryStats(ctx *gin.Context) {
+	c.sendCollected(ctx, "memory", "Failed to get memory information")
+}
+
+// GetDiskStats handles GET request for disk information with numeric values
+// @Summary Get disk information (v2)
+// @Description Retrieve total, used, and free disk space in bytes and the used percentage
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} models.DiskV2
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/disk [get]
+func (c *SystemV2Controller) GetDiskStats(ctx *gin.Context) {
+	c.sendCollected(ctx, "disk", "Failed to get disk information")
+}
+
+// GetUsageStats handles GET request for current usage with numeric values
+// @Summary Get usage (v2)
+// @Description Retrieve CPU, GPU, memory, and disk usage percentages and disk throughput from the background sampler, optionally averaged over a window
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param format query string false "Set to human to render numbers with units"
+// @Param window query string false "Average over this duration, e.g. 10s or 1m (default: latest sample)"
+// @Success 200 {object} models.UsageV2
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/usage [get]
+func (c *SystemV2Controller) GetUsageStats(ctx *gin.Context) {
+	window, err := parseDurationParam(ctx.Query("window"))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid window", err)
+		return
+	}
+
+	data, err := c.systemService.GetUsageStats(ctx.Request.Context(), window)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidWindow) {
+			c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid window", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to get usage", err)
+		return
+	}
+
+	c.respond(ctx, http.StatusOK, data)
+}
+
+// ListCollectors handles GET request for the registered collectors
+// @Summary List collectors (v2)
+// @Description List every registered collector with how long its results stay fresh
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.CollectorInfo
+// @Router /api/v2/collectors [get]
+func (c *SystemV2Controller) ListCollectors(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, c.systemService.ListCollectors())
+}
+
+// Collect handles GET request for a single collector with its v2 model
+// @Summary Run a collector (v2)
+// @Description Run one registered collector by name, e.g. os, network, sensors, or power, and return its v2 model
+// @Tags v2
+// @Accept json
+// @Produce json
+// @Param name path string true "Collector name"
+// @Param format query string false "Set to human to render numbers with units"
+// @Param fresh query bool false "Set to 1 to bypass cached results"
+// @Success 200 {object} object
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v2/collectors/{name} [get]
+func (c *SystemV2Controller) Collect(ctx *gin.Context) {
+	result, err := c.systemService.CollectResultV2(ctx.Request.Context(), ctx.Param("name"), wantsFresh(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrCollectorNotFound) {
+			c.sendErrorResponse(ctx, http.StatusNotFound, "Collector not found", err)
+			return
+		}
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to run collector", err)
+		return
+	}
+
+	setCacheHeaders(ctx, result.Age(), result.MaxAge())
+	c.respond(ctx, http.StatusOK, result.Value)
+}
+
+// sendCollected runs the named v2 collector, served from the cache when fresh, and sends its data with cache headers
+func (c *SystemV2Controller) sendCollected(ctx *gin.Context, name, message string) {
+	result, err := c.systemService.CollectResultV2(ctx.Request.Context(), name, wantsFresh(ctx))
+	if err != nil {
+		c.sendErrorResponse(ctx, http.StatusInternalServerError, message, err)
+		return
+	}
+
+	setCacheHeaders(ctx, result.Age(), result.MaxAge())
+	c.respond(ctx, http.StatusOK, result.Value)
+}
+
+// respond writes data as JSON, rendering numbers with units when ?format=human is set
+func (c *SystemV2Controller) respond(ctx *gin.Context, statusCode int, data any) {
+	switch ctx.Query("format") {
+	case "", "raw":
+		ctx.JSON(statusCode, data)
+	case "human":
+		human, err := utils.Humanize(data)
+		if err != nil {
+			c.sendErrorResponse(ctx, http.StatusInternalServerError, "Failed to format response", err)
+			return
+		}
+		ctx.JSON(statusCode, human)
+	default:
+		c.sendErrorResponse(ctx, http.StatusBadRequest, "Invalid format", errors.New("format must be raw or human"))
+	}
+}
+
+// sendErrorResponse sends a standardized error response
+func (c *SystemV2Controller) sendErrorResponse(ctx *gin.Context, statusCode int, message string, err error) {
+	errorResponse := models.ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	ctx.JSON(statusCode, errorResponse)
+}
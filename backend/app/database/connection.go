@@ -58,11 +58,84 @@ func (db *DB) initSchema() error {
 		end_time DATETIME NOT NULL,
 		repeat_pattern TEXT NOT NULL DEFAULT 'once',
 		enabled BOOLEAN NOT NULL DEFAULT 1,
+		require_ac_power BOOLEAN NOT NULL DEFAULT 0,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
+
+	CREATE TABLE IF NOT EXISTS usage_samples (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		sampled_at INTEGER NOT NULL,
+		cpu REAL NOT NULL,
+		memory REAL NOT NULL,
+		disk REAL NOT NULL,
+		gpu REAL NOT NULL,
+		disk_read REAL NOT NULL DEFAULT 0,
+		disk_write REAL NOT NULL DEFAULT 0
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_usage_samples_sampled_at ON usage_samples (sampled_at);
+
+	CREATE TABLE IF NOT EXISTS process_audit (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		performed_at INTEGER NOT NULL,
+		pid INTEGER NOT NULL,
+		process_name TEXT NOT NULL DEFAULT '',
+		action TEXT NOT NULL,
+		detail TEXT NOT NULL DEFAULT '',
+		outcome TEXT NOT NULL,
+		error TEXT NOT NULL DEFAULT '',
+		source TEXT NOT NULL DEFAULT ''
+	);
 	`
 
-	_, err := db.conn.Exec(createTableSQL)
+	if _, err := db.conn.Exec(createTableSQL); err != nil {
+		return err
+	}
+
+	// Columns added after the initial release; CREATE TABLE IF NOT EXISTS leaves older tables as they were
+	migrations := []struct{ table, column, definition string }{
+		{"usage_samples", "disk_read", "REAL NOT NULL DEFAULT 0"},
+		{"usage_samples", "disk_write", "REAL NOT NULL DEFAULT 0"},
+		{"schedules", "require_ac_power", "BOOLEAN NOT NULL DEFAULT 0"},
+	}
+	for _, m := range migrations {
+		if err := db.addColumnIfMissing(m.table, m.column, m.definition); err != nil {
+			return fmt.Errorf("failed to add %s.%s: %w", m.table, m.column, err)
+		}
+	}
+
+	return nil
+}
+
+// addColumnIfMissing adds a column to an existing table unless it is already present
+func (db *DB) addColumnIfMissing(table, column, definition string) error {
+	rows, err := db.conn.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid          int
+			name         string
+			columnType   string
+			notNull      bool
+			defaultValue sql.NullString
+			primaryKey   int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = db.conn.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
 	return err
 }
 
@@ -70,4 +143,3 @@ func (db *DB) initSchema() error {
 func (db *DB) Close() error {
 	return db.conn.Close()
 }
-
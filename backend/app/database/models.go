@@ -4,12 +4,49 @@ import "time"
 
 // Schedule represents a scheduled task
 type Schedule struct {
-	ID            int       `json:"id"`
-	Title         string    `json:"title"`
-	StartTime     time.Time `json:"start_time"`
-	EndTime       time.Time `json:"end_time"`
-	RepeatPattern string    `json:"repeat_pattern"` // once, daily, weekly
-	Enabled       bool      `json:"enabled"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID             int       `json:"id"`
+	Title          string    `json:"title"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	RepeatPattern  string    `json:"repeat_pattern"` // once, daily, weekly
+	Enabled        bool      `json:"enabled"`
+	RequireACPower bool      `json:"require_ac_power"` // skip launching while running on battery
+	CreatedAt      time.Time `json:"created_at"`
 }
 
+// UsageSample represents a single point-in-time usage measurement
+type UsageSample struct {
+	ID        int       `json:"id"`
+	SampledAt time.Time `json:"sampled_at"`
+	CPU       float64   `json:"cpu"`
+	Memory    float64   `json:"memory"`
+	Disk      float64   `json:"disk"`
+	GPU       float64   `json:"gpu"`
+	DiskRead  float64   `json:"disk_read"`  // bytes per second
+	DiskWrite float64   `json:"disk_write"` // bytes per second
+}
+
+// UsageBucket represents averaged usage samples over a fixed time step
+type UsageBucket struct {
+	Start     time.Time `json:"start"`
+	CPU       float64   `json:"cpu"`
+	Memory    float64   `json:"memory"`
+	Disk      float64   `json:"disk"`
+	GPU       float64   `json:"gpu"`
+	DiskRead  float64   `json:"disk_read"`
+	DiskWrite float64   `json:"disk_write"`
+	Samples   int       `json:"samples"`
+}
+
+// ProcessAudit represents an attempted action on a process
+type ProcessAudit struct {
+	ID          int       `json:"id"`
+	PerformedAt time.Time `json:"performed_at"`
+	PID         int32     `json:"pid"`
+	ProcessName string    `json:"process_name"`
+	Action      string    `json:"action"`
+	Detail      string    `json:"detail,omitempty"`
+	Outcome     string    `json:"outcome"` // succeeded, failed, refused
+	Error       string    `json:"error,omitempty"`
+	Source      string    `json:"source"` // api, desktop
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// AddProcessAudit stores a process action in the audit log
+func (db *DB) AddProcessAudit(entry *ProcessAudit) error {
+	if db == nil || db.conn == nil {
+		return fmt.Errorf("database connection not initialized")
+	}
+
+	if entry == nil {
+		return fmt.Errorf("audit entry cannot be nil")
+	}
+
+	query := `
+	INSERT INTO process_audit (performed_at, pid, process_name, action, detail, outcome, error, source)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := db.conn.Exec(
+		query,
+		entry.PerformedAt.Unix(),
+		entry.PID,
+		entry.ProcessName,
+		entry.Action,
+		entry.Detail,
+		entry.Outcome,
+		entry.Error,
+		entry.Source,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	entry.ID = int(id)
+	return nil
+}
+
+// GetProcessAudits retrieves the most recent process actions, newest first
+func (db *DB) GetProcessAudits(limit int) ([]*ProcessAudit, error) {
+	query := `
+	SELECT id, performed_at, pid, process_name, action, detail, outcome, error, source
+	FROM process_audit ORDER BY id DESC LIMIT ?
+	`
+
+	rows, err := db.conn.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*ProcessAudit{}
+	for rows.Next() {
+		var performedAt int64
+		entry := &ProcessAudit{}
+		err := rows.Scan(
+			&entry.ID,
+			&performedAt,
+			&entry.PID,
+			&entry.ProcessName,
+			&entry.Action,
+			&entry.Detail,
+			&entry.Outcome,
+			&entry.Error,
+			&entry.Source,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entry.PerformedAt = time.Unix(performedAt, 0).UTC()
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
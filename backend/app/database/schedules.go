@@ -13,11 +13,11 @@ func (db *DB) AddSchedule(schedule *Schedule) error {
 	}
 
 	query := `
-	INSERT INTO schedules (title, start_time, end_time, repeat_pattern, enabled)
-	VALUES (?, ?, ?, ?, ?)
+	INSERT INTO schedules (title, start_time, end_time, repeat_pattern, enabled, require_ac_power)
+	VALUES (?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := db.conn.Exec(query, schedule.Title, schedule.StartTime, schedule.EndTime, schedule.RepeatPattern, schedule.Enabled)
+	result, err := db.conn.Exec(query, schedule.Title, schedule.StartTime, schedule.EndTime, schedule.RepeatPattern, schedule.Enabled, schedule.RequireACPower)
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,7 @@ func (db *DB) AddSchedule(schedule *Schedule) error {
 // GetSchedule retrieves a schedule by ID
 func (db *DB) GetSchedule(id int) (*Schedule, error) {
 	query := `
-	SELECT id, title, start_time, end_time, repeat_pattern, enabled, created_at
+	SELECT id, title, start_time, end_time, repeat_pattern, enabled, require_ac_power, created_at
 	FROM schedules WHERE id = ?
 	`
 
@@ -48,6 +48,7 @@ func (db *DB) GetSchedule(id int) (*Schedule, error) {
 		&schedule.EndTime,
 		&schedule.RepeatPattern,
 		&schedule.Enabled,
+		&schedule.RequireACPower,
 		&schedule.CreatedAt,
 	)
 
@@ -61,7 +62,7 @@ func (db *DB) GetSchedule(id int) (*Schedule, error) {
 // GetAllSchedules retrieves all schedules
 func (db *DB) GetAllSchedules() ([]*Schedule, error) {
 	query := `
-	SELECT id, title, start_time, end_time, repeat_pattern, enabled, created_at
+	SELECT id, title, start_time, end_time, repeat_pattern, enabled, require_ac_power, created_at
 	FROM schedules ORDER BY created_at DESC
 	`
 
@@ -81,6 +82,7 @@ func (db *DB) GetAllSchedules() ([]*Schedule, error) {
 			&schedule.EndTime,
 			&schedule.RepeatPattern,
 			&schedule.Enabled,
+			&schedule.RequireACPower,
 			&schedule.CreatedAt,
 		)
 		if err != nil {
@@ -96,11 +98,11 @@ func (db *DB) GetAllSchedules() ([]*Schedule, error) {
 func (db *DB) UpdateSchedule(schedule *Schedule) error {
 	query := `
 	UPDATE schedules 
-	SET title = ?, start_time = ?, end_time = ?, repeat_pattern = ?, enabled = ?
+	SET title = ?, start_time = ?, end_time = ?, repeat_pattern = ?, enabled = ?, require_ac_power = ?
 	WHERE id = ?
 	`
 
-	_, err := db.conn.Exec(query, schedule.Title, schedule.StartTime, schedule.EndTime, schedule.RepeatPattern, schedule.Enabled, schedule.ID)
+	_, err := db.conn.Exec(query, schedule.Title, schedule.StartTime, schedule.EndTime, schedule.RepeatPattern, schedule.Enabled, schedule.RequireACPower, schedule.ID)
 	return err
 }
 
@@ -121,7 +123,7 @@ func (db *DB) ToggleSchedule(id int, enabled bool) error {
 // GetEnabledSchedules retrieves all enabled schedules
 func (db *DB) GetEnabledSchedules() ([]*Schedule, error) {
 	query := `
-	SELECT id, title, start_time, end_time, repeat_pattern, enabled, created_at
+	SELECT id, title, start_time, end_time, repeat_pattern, enabled, require_ac_power, created_at
 	FROM schedules WHERE enabled = 1 ORDER BY start_time ASC
 	`
 
@@ -141,6 +143,7 @@ func (db *DB) GetEnabledSchedules() ([]*Schedule, error) {
 			&schedule.EndTime,
 			&schedule.RepeatPattern,
 			&schedule.Enabled,
+			&schedule.RequireACPower,
 			&schedule.CreatedAt,
 		)
 		if err != nil {
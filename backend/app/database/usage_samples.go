@@ -0,0 +1,97 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// AddUsageSample stores a usage sample in the database
+func (db *DB) AddUsageSample(sample *UsageSample) error {
+	if db == nil || db.conn == nil {
+		return fmt.Errorf("database connection not initialized")
+	}
+
+	if sample == nil {
+		return fmt.Errorf("usage sample cannot be nil")
+	}
+
+	query := `
+	INSERT INTO usage_samples (sampled_at, cpu, memory, disk, gpu, disk_read, disk_write)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := db.conn.Exec(
+		query,
+		sample.SampledAt.Unix(),
+		sample.CPU,
+		sample.Memory,
+		sample.Disk,
+		sample.GPU,
+		sample.DiskRead,
+		sample.DiskWrite,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	sample.ID = int(id)
+	return nil
+}
+
+// GetUsageBuckets retrieves usage samples in [from, to) averaged into buckets of the given step
+func (db *DB) GetUsageBuckets(from, to time.Time, step time.Duration) ([]*UsageBucket, error) {
+	stepSeconds := int64(step / time.Second)
+	if stepSeconds < 1 {
+		return nil, fmt.Errorf("step must be at least one second")
+	}
+
+	query := `
+	SELECT (sampled_at / ?) * ? AS bucket, AVG(cpu), AVG(memory), AVG(disk), AVG(gpu), AVG(disk_read), AVG(disk_write), COUNT(*)
+	FROM usage_samples WHERE sampled_at >= ? AND sampled_at < ?
+	GROUP BY bucket ORDER BY bucket ASC
+	`
+
+	rows, err := db.conn.Query(query, stepSeconds, stepSeconds, from.Unix(), to.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []*UsageBucket
+	for rows.Next() {
+		var start int64
+		bucket := &UsageBucket{}
+		err := rows.Scan(
+			&start,
+			&bucket.CPU,
+			&bucket.Memory,
+			&bucket.Disk,
+			&bucket.GPU,
+			&bucket.DiskRead,
+			&bucket.DiskWrite,
+			&bucket.Samples,
+		)
+		if err != nil {
+			return nil, err
+		}
+		bucket.Start = time.Unix(start, 0).UTC()
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+// DeleteUsageSamplesBefore removes usage samples older than the given time
+func (db *DB) DeleteUsageSamplesBefore(before time.Time) (int64, error) {
+	query := `DELETE FROM usage_samples WHERE sampled_at < ?`
+	result, err := db.conn.Exec(query, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,174 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/database"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+)
+
+const (
+	// DefaultRange is the history range returned when no start time is given
+	DefaultRange = time.Hour
+	// MaxPoints limits how many buckets a single history query may return
+	MaxPoints = 2000
+)
+
+// ErrInvalidRange is returned when a history query has an unusable time range or step
+var ErrInvalidRange = errors.New("invalid history range")
+
+// HistoryService periodically samples system usage and stores it for later queries
+type HistoryService struct {
+	systemService *services.SystemService
+	db            *database.DB
+	logger        *log.Logger
+	interval      time.Duration
+	retention     time.Duration
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+}
+
+// NewHistoryService creates a new history service
+func NewHistoryService(systemService *services.SystemService, db *database.DB, cfg config.HistoryConfig) *HistoryService {
+	return &HistoryService{
+		systemService: systemService,
+		db:            db,
+		logger:        log.New(os.Stdout, "[HISTORY] ", log.LstdFlags),
+		interval:      time.Duration(cfg.Interval) * time.Second,
+		retention:     time.Duration(cfg.Retention) * time.Hour,
+	}
+}
+
+// StartSampler samples usage at the configured interval until the context is cancelled or StopSampler is called
+func (h *HistoryService) StartSampler(ctx context.Context) {
+	h.mutex.Lock()
+	ctx, h.cancel = context.WithCancel(ctx)
+	h.mutex.Unlock()
+
+	h.logger.Printf("Starting usage sampler with interval: %v, retention: %v", h.interval, h.retention)
+
+	sampleTicker := time.NewTicker(h.interval)
+	defer sampleTicker.Stop()
+
+	pruneTicker := time.NewTicker(time.Hour)
+	defer pruneTicker.Stop()
+
+	h.prune()
+	h.sample(ctx)
+
+	for {
+		select {
+		case <-sampleTicker.C:
+			h.sample(ctx)
+		case <-pruneTicker.C:
+			h.prune()
+		case <-ctx.Done():
+			h.logger.Println("Usage sampler stopping...")
+			return
+		}
+	}
+}
+
+// StopSampler stops a running sampler
+func (h *HistoryService) StopSampler() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+}
+
+// GetHistory returns usage averaged into buckets of the given step over [from, to).
+// A zero to defaults to now, a zero from defaults to DefaultRange before to and a
+// zero step defaults to the sampling interval.
+func (h *HistoryService) GetHistory(from, to time.Time, step time.Duration) (*models.UsageHistory, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-DefaultRange)
+	}
+	if step == 0 {
+		step = h.interval
+	}
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidRange)
+	}
+	if step < time.Second {
+		return nil, fmt.Errorf("%w: step must be at least 1s", ErrInvalidRange)
+	}
+	if points := to.Sub(from) / step; points > MaxPoints {
+		return nil, fmt.Errorf("%w: range would return %d points, maximum is %d", ErrInvalidRange, points, MaxPoints)
+	}
+
+	buckets, err := h.db.GetUsageBuckets(from, to, step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usage history: %w", err)
+	}
+
+	series := make([]models.UsagePoint, 0, len(buckets))
+	for _, bucket := range buckets {
+		series = append(series, models.UsagePoint{
+			Timestamp: bucket.Start,
+			CPU:       bucket.CPU,
+			GPU:       bucket.GPU,
+			Memory:    bucket.Memory,
+			Disk:      bucket.Disk,
+			DiskRead:  bucket.DiskRead,
+			DiskWrite: bucket.DiskWrite,
+			Samples:   bucket.Samples,
+		})
+	}
+
+	return &models.UsageHistory{
+		From:   from.UTC(),
+		To:     to.UTC(),
+		Step:   int64(step / time.Second),
+		Series: series,
+	}, nil
+}
+
+// sample takes a single usage sample and stores it
+func (h *HistoryService) sample(ctx context.Context) {
+	usage, err := h.systemService.SampleUsage(ctx)
+	if err != nil {
+		h.logger.Printf("Sampling failed: %v", err)
+		return
+	}
+
+	if err := h.db.AddUsageSample(&database.UsageSample{
+		SampledAt: usage.Timestamp,
+		CPU:       usage.CPU,
+		Memory:    usage.Memory,
+		Disk:      usage.Disk,
+		GPU:       usage.GPU,
+		DiskRead:  usage.DiskRead,
+		DiskWrite: usage.DiskWrite,
+	}); err != nil {
+		h.logger.Printf("Failed to store usage sample: %v", err)
+	}
+}
+
+// prune removes samples older than the retention period
+func (h *HistoryService) prune() {
+	removed, err := h.db.DeleteUsageSamplesBefore(time.Now().Add(-h.retention))
+	if err != nil {
+		h.logger.Printf("Failed to prune usage history: %v", err)
+		return
+	}
+	if removed > 0 {
+		h.logger.Printf("Pruned %d usage samples older than %v", removed, h.retention)
+	}
+}
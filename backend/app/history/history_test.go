@@ -0,0 +1,109 @@
+package history
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/database"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// base is a whole multiple of every step used below, so buckets start on it
+var base = time.Unix(1700000400, 0).UTC()
+
+func TestGetHistoryBuckets(t *testing.T) {
+	h := newTestHistory(t, map[time.Duration]float64{
+		-time.Second:      99, // before the range
+		0:                 10,
+		30 * time.Second:  30,
+		60 * time.Second:  50,
+		150 * time.Second: 70,
+		300 * time.Second: 99, // the end of the range is exclusive
+	})
+
+	point := func(offset time.Duration, cpu float64, samples int) models.UsagePoint {
+		return models.UsagePoint{Timestamp: base.Add(offset), CPU: cpu, Memory: cpu, Samples: samples}
+	}
+
+	tests := []struct {
+		name string
+		step time.Duration
+		want []models.UsagePoint
+	}{
+		{
+			name: "minute buckets skip empty ones",
+			step: time.Minute,
+			want: []models.UsagePoint{point(0, 20, 2), point(time.Minute, 50, 1), point(2*time.Minute, 70, 1)},
+		},
+		{
+			name: "default step is the sampling interval",
+			want: []models.UsagePoint{point(0, 20, 2), point(time.Minute, 50, 1), point(2*time.Minute, 70, 1)},
+		},
+		{
+			name: "one bucket for the whole range",
+			step: 5 * time.Minute,
+			want: []models.UsagePoint{point(0, 40, 4)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history, err := h.GetHistory(base, base.Add(5*time.Minute), tt.step)
+			if err != nil {
+				t.Fatalf("GetHistory() error = %v", err)
+			}
+			if !reflect.DeepEqual(history.Series, tt.want) {
+				t.Errorf("GetHistory() series = %+v, want %+v", history.Series, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHistoryRejectsInvalidRanges(t *testing.T) {
+	h := newTestHistory(t, nil)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		step     time.Duration
+	}{
+		{name: "from after to", from: base.Add(time.Hour), to: base, step: time.Minute},
+		{name: "empty range", from: base, to: base, step: time.Minute},
+		{name: "step under a second", from: base, to: base.Add(time.Hour), step: time.Millisecond},
+		{name: "too many points", from: base, to: base.Add((MaxPoints + 1) * time.Second), step: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := h.GetHistory(tt.from, tt.to, tt.step); !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("GetHistory() error = %v, want ErrInvalidRange", err)
+			}
+		})
+	}
+}
+
+// newTestHistory creates a history service sampling every minute, whose database lives in a temporary
+// home directory and holds a sample with the given CPU and memory usage at each offset from base
+func newTestHistory(t *testing.T, samples map[time.Duration]float64) *HistoryService {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	db, err := database.NewDB()
+	if err != nil {
+		t.Fatalf("NewDB() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for offset, usage := range samples {
+		if err := db.AddUsageSample(&database.UsageSample{SampledAt: base.Add(offset), CPU: usage, Memory: usage}); err != nil {
+			t.Fatalf("AddUsageSample() error = %v", err)
+		}
+	}
+
+	return NewHistoryService(nil, db, config.HistoryConfig{Interval: 60, Retention: 168})
+}
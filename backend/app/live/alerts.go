@@ -0,0 +1,52 @@
+package live
+
+import (
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// AlertMonitor raises an alert when a usage percentage rises above its threshold.
+// Each metric alerts once per crossing and re-arms when it falls back below.
+type AlertMonitor struct {
+	thresholds config.AlertConfig
+	active     map[string]bool
+}
+
+// NewAlertMonitor creates a monitor for the given thresholds
+func NewAlertMonitor(thresholds config.AlertConfig) *AlertMonitor {
+	return &AlertMonitor{
+		thresholds: thresholds,
+		active:     make(map[string]bool),
+	}
+}
+
+// Check returns the alerts newly raised by a sample
+func (m *AlertMonitor) Check(sample models.UsageSample) []models.UsageAlert {
+	var alerts []models.UsageAlert
+
+	check := func(metric string, value, threshold float64) {
+		if threshold <= 0 {
+			return
+		}
+		if value < threshold {
+			m.active[metric] = false
+			return
+		}
+		if !m.active[metric] {
+			m.active[metric] = true
+			alerts = append(alerts, models.UsageAlert{
+				Metric:    metric,
+				Value:     value,
+				Threshold: threshold,
+				Timestamp: sample.Timestamp,
+			})
+		}
+	}
+
+	check("cpu", sample.CPU, m.thresholds.CPU)
+	check("gpu", sample.GPU, m.thresholds.GPU)
+	check("memory", sample.Memory, m.thresholds.Memory)
+	check("disk", sample.Disk, m.thresholds.Disk)
+
+	return alerts
+}
@@ -0,0 +1,163 @@
+package live
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+)
+
+// MaxInterval is the longest delivery interval a subscriber may request
+const MaxInterval = time.Hour
+
+// Subscription receives usage samples at its own interval until it is unsubscribed
+type Subscription struct {
+	// C delivers the most recent sample; samples the subscriber is too slow to read are replaced
+	C <-chan models.UsageSample
+
+	ch       chan models.UsageSample
+	interval time.Duration
+	lastSent time.Time
+}
+
+// Interval returns the effective delivery interval after clamping
+func (s *Subscription) Interval() time.Duration {
+	return s.interval
+}
+
+// UsageBroadcaster shares a single usage sampler between any number of subscribers.
+// The sampler only runs while at least one subscriber is registered.
+type UsageBroadcaster struct {
+	systemService *services.SystemService
+	logger        *log.Logger
+	interval      time.Duration
+
+	mutex       sync.Mutex
+	subscribers map[*Subscription]struct{}
+	latest      *models.UsageSample
+	cancel      context.CancelFunc
+}
+
+// NewUsageBroadcaster creates a broadcaster that samples at the configured interval
+func NewUsageBroadcaster(systemService *services.SystemService, cfg config.LiveConfig) *UsageBroadcaster {
+	return &UsageBroadcaster{
+		systemService: systemService,
+		logger:        log.New(os.Stdout, "[LIVE] ", log.LstdFlags),
+		interval:      time.Duration(cfg.Interval) * time.Second,
+		subscribers:   make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber that wants a sample every interval. The interval is
+// clamped between the sampling interval and MaxInterval; zero means the sampling interval.
+func (b *UsageBroadcaster) Subscribe(interval time.Duration) *Subscription {
+	if interval < b.interval {
+		interval = b.interval
+	}
+	if interval > MaxInterval {
+		interval = MaxInterval
+	}
+
+	ch := make(chan models.UsageSample, 1)
+	sub := &Subscription{C: ch, ch: ch, interval: interval}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.subscribers[sub] = struct{}{}
+
+	// Hand out the last sample straight away if it is still current
+	if b.latest != nil && time.Since(b.latest.Timestamp) < b.interval {
+		sub.ch <- *b.latest
+		sub.lastSent = time.Now()
+	}
+
+	if b.cancel == nil {
+		var ctx context.Context
+		ctx, b.cancel = context.WithCancel(context.Background())
+		go b.run(ctx)
+		b.logger.Printf("Usage sampler started with interval: %v", b.interval)
+	}
+
+	return sub
+}
+
+// Unsubscribe removes a subscriber and closes its channel. The sampler stops with the last subscriber.
+func (b *UsageBroadcaster) Unsubscribe(sub *Subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+
+	if len(b.subscribers) == 0 && b.cancel != nil {
+		b.cancel()
+		b.cancel = nil
+		b.logger.Println("Usage sampler stopped, no subscribers left")
+	}
+}
+
+// Subscribers returns the number of active subscribers
+func (b *UsageBroadcaster) Subscribers() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.subscribers)
+}
+
+// run samples usage until the context is cancelled
+func (b *UsageBroadcaster) run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		b.sample(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sample takes one usage sample and delivers it to every subscriber that is due
+func (b *UsageBroadcaster) sample(ctx context.Context) {
+	usage, err := b.systemService.SampleUsage(ctx)
+	if err != nil {
+		b.logger.Printf("Sampling failed: %v", err)
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	// Subscribers may have gone away while the sample was being taken
+	if ctx.Err() != nil {
+		return
+	}
+
+	b.latest = usage
+	now := time.Now()
+	for sub := range b.subscribers {
+		// Allow half a sampling interval of jitter so subscribers are not skipped a whole tick
+		if now.Sub(sub.lastSent) < sub.interval-b.interval/2 {
+			continue
+		}
+
+		// Replace an unread sample rather than blocking on a slow subscriber
+		select {
+		case <-sub.ch:
+		default:
+		}
+		sub.ch <- *usage
+		sub.lastSent = now
+	}
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/live"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Events emitted to the frontend while live usage is running
+const (
+	UsageEvent = "system:usage"
+	AlertEvent = "system:alert"
+)
+
+// StartLiveUsage starts emitting system:usage events every interval seconds (0 uses the configured
+// rate) and system:alert events when a usage threshold is crossed. Calling it again changes the interval.
+func (a *App) StartLiveUsage(interval int) error {
+	if a.ctx == nil {
+		return fmt.Errorf("application not started")
+	}
+
+	a.liveMutex.Lock()
+	defer a.liveMutex.Unlock()
+
+	if a.liveSubscription != nil {
+		a.liveBroadcaster.Unsubscribe(a.liveSubscription)
+	}
+
+	sub := a.liveBroadcaster.Subscribe(time.Duration(interval) * time.Second)
+	a.liveSubscription = sub
+	go a.emitLiveUsage(sub)
+
+	a.logger.Printf("Live usage started with interval: %v", sub.Interval())
+	return nil
+}
+
+// StopLiveUsage stops emitting live usage events
+func (a *App) StopLiveUsage() {
+	a.liveMutex.Lock()
+	defer a.liveMutex.Unlock()
+
+	if a.liveSubscription == nil {
+		return
+	}
+
+	a.liveBroadcaster.Unsubscribe(a.liveSubscription)
+	a.liveSubscription = nil
+	a.logger.Println("Live usage stopped")
+}
+
+// emitLiveUsage forwards samples to the frontend until the subscription is closed
+func (a *App) emitLiveUsage(sub *live.Subscription) {
+	alerts := live.NewAlertMonitor(a.config.Live.Alerts)
+
+	for sample := range sub.C {
+		runtime.EventsEmit(a.ctx, UsageEvent, sample)
+
+		for _, alert := range alerts.Check(sample) {
+			runtime.EventsEmit(a.ctx, AlertEvent, alert)
+		}
+	}
+}
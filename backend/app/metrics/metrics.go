@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes every metric exposed by the application
+const Namespace = "sysbench"
+
+// Registry holds all application metrics exposed on /metrics
+var Registry = prometheus.NewRegistry()
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP API requests.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"method", "route", "status"})
+
+	schedulerSyncDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "scheduler",
+		Name:      "sync_duration_seconds",
+		Help:      "Duration of synchronizations between the database and the system scheduler.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	schedulerSyncFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "scheduler",
+		Name:      "sync_failures_total",
+		Help:      "Number of failed synchronizations with the system scheduler.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestDuration,
+		schedulerSyncDuration,
+		schedulerSyncFailures,
+	)
+}
+
+// Handler returns an HTTP handler serving the metrics in Registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveRequest records the latency of a completed HTTP request
+func ObserveRequest(method, route, status string, duration time.Duration) {
+	requestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
+
+// ObserveSchedulerSync records the duration and outcome of a synchronization with the system scheduler,
+// whether run by the desktop watcher or requested through the API
+func ObserveSchedulerSync(duration time.Duration, err error) {
+	schedulerSyncDuration.Observe(duration.Seconds())
+	if err != nil {
+		schedulerSyncFailures.Inc()
+	}
+}
@@ -0,0 +1,184 @@
+package metrics
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapeTimeout bounds how long one scrape may spend reading the system
+const scrapeTimeout = 10 * time.Second
+
+// SystemCollector exposes readings from SystemService as Prometheus metrics
+type SystemCollector struct {
+	systemService *services.SystemService
+	logger        *log.Logger
+
+	cpuUsage        *prometheus.Desc
+	cpuCoreUsage    *prometheus.Desc
+	cpuSeconds      *prometheus.Desc
+	memoryTotal     *prometheus.Desc
+	memoryUsed      *prometheus.Desc
+	memoryFree      *prometheus.Desc
+	memoryAvailable *prometheus.Desc
+	swapTotal       *prometheus.Desc
+	swapUsed        *prometheus.Desc
+	swapFree        *prometheus.Desc
+	diskTotal       *prometheus.Desc
+	diskUsed        *prometheus.Desc
+	diskFree        *prometheus.Desc
+	netRecvBytes    *prometheus.Desc
+	netSentBytes    *prometheus.Desc
+	netRecvPackets  *prometheus.Desc
+	netSentPackets  *prometheus.Desc
+	netRecvErrors   *prometheus.Desc
+	netSentErrors   *prometheus.Desc
+	netRecvDrops    *prometheus.Desc
+	netSentDrops    *prometheus.Desc
+	gpuUsage        *prometheus.Desc
+	gpuInfo         *prometheus.Desc
+}
+
+// NewSystemCollector creates a collector whose metrics carry a host label naming the monitored system
+func NewSystemCollector(systemService *services.SystemService) *SystemCollector {
+	hostname := systemService.Hostname()
+	if hostname == "" {
+		hostname = "unknown"
+	}
+	host := prometheus.Labels{"host": hostname}
+
+	desc := func(subsystem, name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(Namespace, subsystem, name), help, labels, host)
+	}
+
+	return &SystemCollector{
+		systemService: systemService,
+		logger:        log.New(os.Stdout, "[METRICS] ", log.LstdFlags),
+
+		cpuUsage:        desc("cpu", "usage_percent", "CPU usage across all cores since the previous scrape."),
+		cpuCoreUsage:    desc("cpu", "core_usage_percent", "CPU usage per core since the previous scrape.", "core"),
+		cpuSeconds:      desc("cpu", "seconds_total", "Seconds each CPU spent in each mode.", "cpu", "mode"),
+		memoryTotal:     desc("memory", "total_bytes", "Total physical memory."),
+		memoryUsed:      desc("memory", "used_bytes", "Used physical memory."),
+		memoryFree:      desc("memory", "free_bytes", "Free physical memory."),
+		memoryAvailable: desc("memory", "available_bytes", "Memory available for new allocations."),
+		swapTotal:       desc("swap", "total_bytes", "Total swap space."),
+		swapUsed:        desc("swap", "used_bytes", "Used swap space."),
+		swapFree:        desc("swap", "free_bytes", "Free swap space."),
+		diskTotal:       desc("disk", "total_bytes", "Total filesystem size.", "device", "mountpoint", "fstype"),
+		diskUsed:        desc("disk", "used_bytes", "Used filesystem space.", "device", "mountpoint", "fstype"),
+		diskFree:        desc("disk", "free_bytes", "Free filesystem space.", "device", "mountpoint", "fstype"),
+		netRecvBytes:    desc("network", "receive_bytes_total", "Bytes received per interface.", "interface"),
+		netSentBytes:    desc("network", "transmit_bytes_total", "Bytes transmitted per interface.", "interface"),
+		netRecvPackets:  desc("network", "receive_packets_total", "Packets received per interface.", "interface"),
+		netSentPackets:  desc("network", "transmit_packets_total", "Packets transmitted per interface.", "interface"),
+		netRecvErrors:   desc("network", "receive_errors_total", "Receive errors per interface.", "interface"),
+		netSentErrors:   desc("network", "transmit_errors_total", "Transmit errors per interface.", "interface"),
+		netRecvDrops:    desc("network", "receive_drops_total", "Dropped incoming packets per interface.", "interface"),
+		netSentDrops:    desc("network", "transmit_drops_total", "Dropped outgoing packets per interface.", "interface"),
+		gpuUsage:        desc("gpu", "usage_percent", "GPU utilization.", "gpu"),
+		gpuInfo:         desc("gpu", "info", "GPU name and driver, always 1.", "gpu", "name", "driver"),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *SystemCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		c.cpuUsage, c.cpuCoreUsage, c.cpuSeconds,
+		c.memoryTotal, c.memoryUsed, c.memoryFree, c.memoryAvailable,
+		c.swapTotal, c.swapUsed, c.swapFree,
+		c.diskTotal, c.diskUsed, c.diskFree,
+		c.netRecvBytes, c.netSentBytes, c.netRecvPackets, c.netSentPackets,
+		c.netRecvErrors, c.netSentErrors, c.netRecvDrops, c.netSentDrops,
+		c.gpuUsage, c.gpuInfo,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector
+func (c *SystemCollector) Collect(ch chan<- prometheus.Metric) {
+	// Scrapes carry no context, so bound them here
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	snapshot, err := c.systemService.GetMetricsSnapshot(ctx)
+	if err != nil {
+		// Sections that failed are simply missing from the snapshot
+		c.logger.Printf("Partial system metrics: %v", err)
+	}
+
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+	counter := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, labels...)
+	}
+
+	// Sections that failed are skipped rather than reported as zero
+	if snapshot.CPUUsage != nil {
+		gauge(c.cpuUsage, *snapshot.CPUUsage)
+	}
+	for core, usage := range snapshot.CPUCoreUsage {
+		gauge(c.cpuCoreUsage, usage, strconv.Itoa(core))
+	}
+	for _, t := range snapshot.CPUTimes {
+		counter(c.cpuSeconds, t.User, t.CPU, "user")
+		counter(c.cpuSeconds, t.System, t.CPU, "system")
+		counter(c.cpuSeconds, t.Idle, t.CPU, "idle")
+		counter(c.cpuSeconds, t.Nice, t.CPU, "nice")
+		counter(c.cpuSeconds, t.IOWait, t.CPU, "iowait")
+		counter(c.cpuSeconds, t.IRQ, t.CPU, "irq")
+		counter(c.cpuSeconds, t.SoftIRQ, t.CPU, "softirq")
+		counter(c.cpuSeconds, t.Steal, t.CPU, "steal")
+	}
+
+	if memory := snapshot.Memory; memory != nil {
+		gauge(c.memoryTotal, float64(memory.Total))
+		gauge(c.memoryUsed, float64(memory.Used))
+		gauge(c.memoryFree, float64(memory.Free))
+		gauge(c.memoryAvailable, float64(memory.Available))
+	}
+
+	if swap := snapshot.Swap; swap != nil {
+		gauge(c.swapTotal, float64(swap.Total))
+		gauge(c.swapUsed, float64(swap.Used))
+		gauge(c.swapFree, float64(swap.Free))
+	}
+
+	// A filesystem mounted twice at the same mountpoint would produce duplicate series
+	seenMounts := make(map[string]bool)
+	for _, d := range snapshot.Disks {
+		if seenMounts[d.Mountpoint] {
+			continue
+		}
+		seenMounts[d.Mountpoint] = true
+
+		gauge(c.diskTotal, float64(d.Total), d.Device, d.Mountpoint, d.Fstype)
+		gauge(c.diskUsed, float64(d.Used), d.Device, d.Mountpoint, d.Fstype)
+		gauge(c.diskFree, float64(d.Free), d.Device, d.Mountpoint, d.Fstype)
+	}
+
+	for _, n := range snapshot.Network {
+		counter(c.netRecvBytes, float64(n.BytesRecv), n.Name)
+		counter(c.netSentBytes, float64(n.BytesSent), n.Name)
+		counter(c.netRecvPackets, float64(n.PacketsRecv), n.Name)
+		counter(c.netSentPackets, float64(n.PacketsSent), n.Name)
+		counter(c.netRecvErrors, float64(n.ErrorsIn), n.Name)
+		counter(c.netSentErrors, float64(n.ErrorsOut), n.Name)
+		counter(c.netRecvDrops, float64(n.DropsIn), n.Name)
+		counter(c.netSentDrops, float64(n.DropsOut), n.Name)
+	}
+
+	for _, g := range snapshot.GPUs {
+		index := strconv.Itoa(g.Index)
+		gauge(c.gpuUsage, g.UsagePercent, index)
+		gauge(c.gpuInfo, 1, index, g.Name, g.Driver)
+	}
+}
@@ -1,18 +1,34 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/kishansakhiya/wails-demo/backend/app/metrics"
+
 	"github.com/gin-gonic/gin"
 )
 
-// CORS middleware for handling Cross-Origin Resource Sharing
-func CORS() gin.HandlerFunc {
+// CORS middleware for handling Cross-Origin Resource Sharing.
+// Paths matching one of the private patterns (path.Match syntax) get no CORS headers,
+// so browsers keep them same-origin.
+func CORS(private ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		for _, pattern := range private {
+			if matched, _ := path.Match(pattern, c.Request.URL.Path); matched {
+				c.Next()
+				return
+			}
+		}
+
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
@@ -28,6 +44,43 @@ func CORS() gin.HandlerFunc {
 	}
 }
 
+// RequireToken middleware for endpoints that change system state. Requests must send
+// "Authorization: Bearer <token>", and browser requests must come from the API's own
+// origin or one of origins, so other sites cannot drive the endpoints through a visitor.
+func RequireToken(token string, origins []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if origin := c.GetHeader("Origin"); origin != "" && !allowedOrigin(origin, c.Request.Host, origins) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   "Forbidden",
+				"message": fmt.Sprintf("Origin %s is not allowed", origin),
+			})
+			return
+		}
+
+		provided, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"message": "A valid bearer token is required",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// allowedOrigin reports whether origin is the API's own host or one of the configured origins
+func allowedOrigin(origin, host string, origins []string) bool {
+	for _, allowed := range origins {
+		if strings.EqualFold(origin, allowed) {
+			return true
+		}
+	}
+	parsed, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(parsed.Host, host)
+}
+
 // RequestLogger middleware for logging HTTP requests
 func RequestLogger() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
@@ -45,6 +98,21 @@ func RequestLogger() gin.HandlerFunc {
 	})
 }
 
+// Metrics middleware for recording request latency
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// Use the route pattern rather than the raw path to keep label cardinality bounded
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveRequest(c.Request.Method, route, strconv.Itoa(c.Writer.Status()), time.Since(start))
+	}
+}
+
 // Recovery middleware for handling panics
 func Recovery() gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered any) {
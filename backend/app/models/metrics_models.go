@@ -0,0 +1,73 @@
+package models
+
+// MetricsSnapshot represents raw numeric system readings used for metrics exposition
+// @Description Raw numeric CPU, memory, swap, disk, network, and GPU readings. Readings that failed are left out rather than reported as zero.
+type MetricsSnapshot struct {
+	CPUUsage     *float64          `json:"cpu_usage,omitempty"`
+	CPUCoreUsage []float64         `json:"cpu_core_usage"`
+	CPUTimes     []CPUTimes        `json:"cpu_times"`
+	Memory       *MemoryStats      `json:"memory,omitempty"`
+	Swap         *SwapStats        `json:"swap,omitempty"`
+	Disks        []DiskUsage       `json:"disks"`
+	Network      []NetworkCounters `json:"network"`
+	GPUs         []GPUReading      `json:"gpus"`
+}
+
+// CPUTimes represents cumulative time spent by a CPU in each mode, in seconds
+type CPUTimes struct {
+	CPU     string  `json:"cpu"`
+	User    float64 `json:"user"`
+	System  float64 `json:"system"`
+	Idle    float64 `json:"idle"`
+	Nice    float64 `json:"nice"`
+	IOWait  float64 `json:"iowait"`
+	IRQ     float64 `json:"irq"`
+	SoftIRQ float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
+}
+
+// MemoryStats represents physical memory in bytes
+type MemoryStats struct {
+	Total     uint64 `json:"total"`
+	Used      uint64 `json:"used"`
+	Free      uint64 `json:"free"`
+	Available uint64 `json:"available"`
+}
+
+// SwapStats represents swap space in bytes
+type SwapStats struct {
+	Total uint64 `json:"total"`
+	Used  uint64 `json:"used"`
+	Free  uint64 `json:"free"`
+}
+
+// DiskUsage represents space usage of a single mounted filesystem in bytes
+type DiskUsage struct {
+	Device     string `json:"device"`
+	Mountpoint string `json:"mountpoint"`
+	Fstype     string `json:"fstype"`
+	Total      uint64 `json:"total"`
+	Used       uint64 `json:"used"`
+	Free       uint64 `json:"free"`
+}
+
+// NetworkCounters represents cumulative traffic counters of a network interface
+type NetworkCounters struct {
+	Name        string `json:"name"`
+	BytesSent   uint64 `json:"bytes_sent"`
+	BytesRecv   uint64 `json:"bytes_recv"`
+	PacketsSent uint64 `json:"packets_sent"`
+	PacketsRecv uint64 `json:"packets_recv"`
+	ErrorsIn    uint64 `json:"errors_in"`
+	ErrorsOut   uint64 `json:"errors_out"`
+	DropsIn     uint64 `json:"drops_in"`
+	DropsOut    uint64 `json:"drops_out"`
+}
+
+// GPUReading represents the numeric utilization of a single GPU
+type GPUReading struct {
+	Index        int     `json:"index"`
+	Name         string  `json:"name"`
+	Driver       string  `json:"driver"`
+	UsagePercent float64 `json:"usage_percent"`
+}
@@ -0,0 +1,104 @@
+package models
+
+import "time"
+
+// ProcessQuery represents sorting, filtering and limiting options for a process listing
+type ProcessQuery struct {
+	Sort  string // cpu, memory, io or start
+	Order string // asc or desc
+	Name  string // case-insensitive substring of the process name
+	User  string // case-insensitive username
+	Limit int
+}
+
+// ProcessList represents a filtered, sorted page of processes
+// @Description Processes matching the query, sorted and limited
+type ProcessList struct {
+	Total     int              `json:"total" example:"312" description:"Number of processes matching the filters before the limit is applied"`
+	Processes []ProcessSummary `json:"processes" description:"Matching processes in sort order"`
+}
+
+// ProcessSummary represents resource usage of a single process
+// @Description Process identity and resource usage
+type ProcessSummary struct {
+	PID           int32     `json:"pid" example:"1234" description:"Process ID"`
+	PPID          int32     `json:"ppid" example:"1" description:"Parent process ID"`
+	Name          string    `json:"name" example:"chrome" description:"Process name"`
+	Username      string    `json:"username" example:"alice" description:"Owner of the process"`
+	Status        string    `json:"status" example:"running" description:"Process state"`
+	CPUPercent    float64   `json:"cpu_percent" example:"12.5" description:"CPU usage since the previous listing (lifetime average the first time a process is seen), may exceed 100 on multi-core systems"`
+	MemoryPercent float32   `json:"memory_percent" example:"3.2" description:"Share of physical memory used"`
+	MemoryRSS     uint64    `json:"memory_rss" example:"524288000" description:"Resident set size in bytes"`
+	IOReadBytes   uint64    `json:"io_read_bytes" example:"1048576" description:"Bytes read from storage since the process started"`
+	IOWriteBytes  uint64    `json:"io_write_bytes" example:"2097152" description:"Bytes written to storage since the process started"`
+	NumThreads    int32     `json:"num_threads" example:"24" description:"Number of threads"`
+	StartTime     time.Time `json:"start_time" example:"2024-01-01T03:00:00Z" description:"Time the process started"`
+}
+
+// ProcessDetail represents detailed information about a single process
+// @Description Detailed process information including command line, open files, children, and connections
+type ProcessDetail struct {
+	ProcessSummary
+	Exe         string              `json:"exe" example:"/usr/bin/chrome" description:"Path of the executable"`
+	Cmdline     string              `json:"cmdline" example:"/usr/bin/chrome --type=renderer" description:"Full command line"`
+	Cwd         string              `json:"cwd" example:"/home/alice" description:"Working directory"`
+	Nice        int32               `json:"nice" example:"0" description:"Nice value on Unix (-20 to 19), priority class on Windows"`
+	OpenFiles   int                 `json:"open_files" example:"128" description:"Number of open files"`
+	Children    []int32             `json:"children" example:"1235,1236" description:"PIDs of direct child processes"`
+	Connections []ProcessConnection `json:"connections" description:"Network connections owned by the process"`
+}
+
+// ProcessConnection represents a network connection owned by a process
+// @Description Network connection endpoint and state
+type ProcessConnection struct {
+	Type       string `json:"type" example:"tcp" description:"Protocol (tcp, tcp6, udp, udp6, unix)"`
+	Status     string `json:"status" example:"ESTABLISHED" description:"Connection state"`
+	LocalAddr  string `json:"local_addr" example:"127.0.0.1:7000" description:"Local address"`
+	RemoteAddr string `json:"remote_addr" example:"10.0.0.5:51234" description:"Remote address"`
+}
+
+// ProcessConfirmationRequest represents a request for a confirmation token
+type ProcessConfirmationRequest struct {
+	Action string `json:"action" binding:"required" example:"SIGTERM" description:"SIGTERM, SIGKILL, SIGSTOP, SIGCONT or renice"`
+	Nice   int    `json:"nice" example:"10" description:"Target nice value when the action is renice"`
+}
+
+// ProcessConfirmation represents a single-use token authorizing one action on one process
+// @Description Confirmation token bound to a process, action and nice value
+type ProcessConfirmation struct {
+	Token     string    `json:"token" example:"9f86d081884c7d659a2feaa0c55ad015" description:"Token to pass with the action"`
+	PID       int32     `json:"pid" example:"1234" description:"Process ID the token is bound to"`
+	Name      string    `json:"name" example:"chrome" description:"Name of the process, to show in the confirmation prompt"`
+	Action    string    `json:"action" example:"SIGTERM" description:"Action the token authorizes"`
+	Nice      int       `json:"nice,omitempty" example:"10" description:"Nice value the token authorizes for renice"`
+	ExpiresAt time.Time `json:"expires_at" example:"2024-01-01T12:01:00Z" description:"Time after which the token is rejected"`
+}
+
+// ProcessSignalRequest represents a confirmed request to signal a process
+type ProcessSignalRequest struct {
+	Signal string `json:"signal" binding:"required" example:"SIGTERM" description:"SIGTERM, SIGKILL, SIGSTOP or SIGCONT"`
+	Token  string `json:"token" binding:"required" example:"9f86d081884c7d659a2feaa0c55ad015" description:"Confirmation token"`
+}
+
+// ProcessReniceRequest represents a confirmed request to change the priority of a process
+type ProcessReniceRequest struct {
+	Nice  int    `json:"nice" example:"10" description:"New nice value (-20 to 19)"`
+	Token string `json:"token" binding:"required" example:"9f86d081884c7d659a2feaa0c55ad015" description:"Confirmation token"`
+}
+
+// ProcessActionResult represents an action that was carried out
+// @Description Process action outcome
+type ProcessActionResult struct {
+	PID         int32     `json:"pid" example:"1234" description:"Process ID"`
+	Name        string    `json:"name" example:"chrome" description:"Process name"`
+	Action      string    `json:"action" example:"SIGTERM" description:"Action that was performed"`
+	PerformedAt time.Time `json:"performed_at" example:"2024-01-01T12:00:30Z" description:"Time the action was performed"`
+}
+
+// ProcessWaitResult represents the outcome of waiting for a process to exit
+// @Description Whether the process exited before the timeout
+type ProcessWaitResult struct {
+	PID      int32 `json:"pid" example:"1234" description:"Process ID"`
+	Exited   bool  `json:"exited" example:"true" description:"Whether the process exited before the timeout"`
+	WaitedMs int64 `json:"waited_ms" example:"350" description:"Milliseconds spent waiting"`
+}
@@ -1,25 +1,99 @@
 package models
 
-// FinalResponse represents the complete system information response
-// @Description Complete system information response containing all system details
+import (
+	"encoding/json"
+	"time"
+)
+
+// Aggregate system information statuses
+const (
+	SystemStatusComplete = "complete"
+	SystemStatusPartial  = "partial"
+	SystemStatusFailed   = "failed"
+)
+
+// FinalResponse represents the complete system information response.
+// Sections are keyed by collector name and serialized at the top level next to status and errors.
+// Built-in sections are cpu, gpus, os, location, memory, disk, hardware, disk_partitions, disk_io,
+// network, sensors, and power; each holds the same model as its dedicated endpoint.
+// @Description Complete system information: every section that succeeded at the top level (cpu, gpus, os, memory, ...), plus status and per-section errors
 type FinalResponse struct {
-	CPU      CPU            `json:"cpu"`
-	GPUs     []GPU          `json:"gpus"`
-	OS       OS             `json:"os"`
-	Location Location       `json:"location"`
-	Memory   Memory         `json:"memory"`
-	Disk     Disk           `json:"disk"`
-	Hardware []HardwareInfo `json:"hardware"`
+	Status   string                  `json:"status" example:"partial" description:"complete, partial, or failed"`
+	Sections map[string]any          `json:"-"`
+	Errors   map[string]SectionError `json:"errors" description:"Errors of the sections that failed, keyed by collector name"`
+
+	// Freshness of the cached sections, reported in response headers
+	Age    time.Duration `json:"-"`
+	MaxAge time.Duration `json:"-"`
+}
+
+// MarshalJSON writes the sections at the top level so responses keep the shape of earlier versions
+func (r FinalResponse) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]any, len(r.Sections)+2)
+	for name, data := range r.Sections {
+		fields[name] = data
+	}
+	fields["status"] = r.Status
+	errors := r.Errors
+	if errors == nil {
+		errors = map[string]SectionError{}
+	}
+	fields["errors"] = errors
+	return json.Marshal(fields)
+}
+
+// SectionError describes why a section of the system information is missing
+// @Description Error of a single system information section
+type SectionError struct {
+	Code       string  `json:"code" example:"network_error" description:"timeout, canceled, network_error, or collector_error"`
+	Message    string  `json:"message" example:"failed to make request: dial tcp: lookup ipinfo.io: no such host" description:"Error message"`
+	DurationMs float64 `json:"duration_ms" example:"5012.4" description:"Time spent on the section before it failed"`
+}
+
+// CollectorInfo describes a registered collector
+// @Description Name and freshness of a registered collector
+type CollectorInfo struct {
+	Name       string  `json:"name" example:"cpu" description:"Collector name, usable with /api/v1/collectors/{name}"`
+	TTLSeconds float64 `json:"ttl_seconds" example:"2" description:"How long a result stays fresh"`
 }
 
 // CPU represents CPU information
-// @Description CPU information including cores, model, cache, frequency, and usage
+// @Description CPU information including cores, model, cache, frequency, usage, time breakdown, and load
 type CPU struct {
-	Cores     int32  `json:"cores" example:"8" description:"Number of CPU cores"`
-	Model     string `json:"model" example:"Intel Core i7-10700K" description:"CPU model name"`
-	CacheSize string `json:"cache_size" example:"8MB" description:"CPU cache size"`
-	Ghz       string `json:"ghz" example:"3.2GHz" description:"CPU frequency"`
-	CPUUsage  string `json:"cpu_usage_percentage" example:"45.2%" description:"Current CPU usage percentage"`
+	Cores                 int32              `json:"cores" example:"8" description:"Number of physical CPU cores across all sockets"`
+	Model                 string             `json:"model" example:"Intel Core i7-10700K" description:"CPU model name"`
+	CacheSize             string             `json:"cache_size" example:"8MB" description:"CPU cache size"`
+	Ghz                   string             `json:"ghz" example:"3.2GHz" description:"CPU frequency"`
+	CPUUsage              string             `json:"cpu_usage_percentage" example:"45.2%" description:"Current CPU usage percentage"`
+	PhysicalCores         int                `json:"physical_cores" example:"8" description:"Number of physical cores across all sockets"`
+	LogicalCores          int                `json:"logical_cores" example:"16" description:"Number of logical processors (hardware threads)"`
+	Sockets               int                `json:"sockets" example:"1" description:"Number of physical CPU packages"`
+	PerCoreUsage          []float64          `json:"per_core_usage" example:"12.5,40.1" description:"Usage percentage of each logical processor"`
+	Times                 CPUTimePercentages `json:"times" description:"Share of CPU time spent in each mode"`
+	LoadAverage           LoadAverage        `json:"load_average" description:"1, 5 and 15 minute load averages"`
+	ContextSwitchesPerSec float64            `json:"context_switches_per_sec" example:"15234" description:"Context switches per second (Linux only)"`
+	InterruptsPerSec      float64            `json:"interrupts_per_sec" example:"8421" description:"Interrupts per second (Linux only)"`
+}
+
+// CPUTimePercentages represents the share of CPU time spent in each mode
+// @Description Percentage of CPU time spent in each mode over the measurement window
+type CPUTimePercentages struct {
+	User    float64 `json:"user" example:"30.5" description:"Time running user space code"`
+	System  float64 `json:"system" example:"10.2" description:"Time running kernel code"`
+	Idle    float64 `json:"idle" example:"55.1" description:"Idle time"`
+	Nice    float64 `json:"nice" example:"0.5" description:"Time running niced user space code"`
+	IOWait  float64 `json:"iowait" example:"2.1" description:"Idle time waiting for I/O"`
+	IRQ     float64 `json:"irq" example:"0.3" description:"Time servicing hardware interrupts"`
+	SoftIRQ float64 `json:"softirq" example:"0.8" description:"Time servicing software interrupts"`
+	Steal   float64 `json:"steal" example:"0.5" description:"Time stolen by the hypervisor for other guests"`
+}
+
+// LoadAverage represents system load averages
+// @Description Average number of runnable processes over 1, 5 and 15 minutes
+type LoadAverage struct {
+	Load1  float64 `json:"load1" example:"1.25" description:"1 minute load average"`
+	Load5  float64 `json:"load5" example:"0.98" description:"5 minute load average"`
+	Load15 float64 `json:"load15" example:"0.75" description:"15 minute load average"`
 }
 
 // GPU represents GPU information
@@ -30,19 +104,55 @@ type GPU struct {
 	Driver     string `json:"driver" example:"470.82.01" description:"GPU driver version"`
 	Usage      string `json:"usage_percentage" example:"30%" description:"Current GPU usage percentage"`
 	ClockSpeed string `json:"clock_speed" example:"1800MHz" description:"GPU clock speed"`
+	PCIAddress string `json:"pci_address,omitempty" example:"0000:01:00.0" description:"PCI slot of the GPU (Linux only)"`
+
+	// Numeric readings behind the formatted fields for the v2 model; zero or nil when unknown
+	VRAMBytes    uint64   `json:"-"`
+	UsagePercent *float64 `json:"-"`
+	ClockMHz     float64  `json:"-"`
 }
 
 // OS represents operating system information
-// @Description Operating system information including name, hostname, platform, version, and uptime
+// @Description Operating system information including name, hostname, platform, version, uptime, virtualization, sessions, timezone, and locale
 type OS struct {
-	OS              string `json:"os" example:"linux" description:"Operating system name (e.g., freebsd, linux)"`
-	Hostname        string `json:"hostname" example:"my-server" description:"System hostname"`
-	Platform        string `json:"platform" example:"ubuntu" description:"Platform name (e.g., ubuntu, linuxmint)"`
-	PlatformVersion string `json:"platform_version" example:"20.04.3 LTS" description:"Complete OS version"`
-	PlatformFamily  string `json:"platform_family" example:"debian" description:"Platform family (e.g., debian, rhel)"`
-	KernelVersion   string `json:"kernel_version" example:"5.4.0-74-generic" description:"OS kernel version"`
-	KernelArch      string `json:"kernel_arch" example:"x86_64" description:"Native CPU architecture"`
-	Uptime          uint64 `json:"uptime" example:"86400" description:"System uptime in seconds"`
+	OS                   string        `json:"os" example:"linux" description:"Operating system name (e.g., freebsd, linux)"`
+	Hostname             string        `json:"hostname" example:"my-server" description:"System hostname"`
+	Platform             string        `json:"platform" example:"ubuntu" description:"Platform name (e.g., ubuntu, linuxmint)"`
+	PlatformVersion      string        `json:"platform_version" example:"20.04.3 LTS" description:"Complete OS version"`
+	PlatformFamily       string        `json:"platform_family" example:"debian" description:"Platform family (e.g., debian, rhel)"`
+	KernelVersion        string        `json:"kernel_version" example:"5.4.0-74-generic" description:"OS kernel version"`
+	KernelArch           string        `json:"kernel_arch" example:"x86_64" description:"Native CPU architecture"`
+	Uptime               uint64        `json:"uptime" example:"86400" description:"System uptime in seconds"`
+	BootTime             time.Time     `json:"boot_time" example:"2024-01-01T03:00:00Z" description:"Time the system booted"`
+	HostID               string        `json:"host_id" example:"8f0c6f5e-1c2d-4a3b-9e8f-7a6b5c4d3e2f" description:"Unique host identifier (machine ID on Linux)"`
+	ProcessCount         uint64        `json:"process_count" example:"312" description:"Number of running processes"`
+	VirtualizationSystem string        `json:"virtualization_system" example:"kvm" description:"Virtualization or container technology detected, e.g. kvm, xen, docker (empty on bare metal)"`
+	VirtualizationRole   string        `json:"virtualization_role" example:"guest" description:"host or guest (empty when no virtualization is detected)"`
+	InitSystem           string        `json:"init_system,omitempty" example:"systemd" description:"Init system running as PID 1 (Linux and macOS)"`
+	Timezone             string        `json:"timezone" example:"Europe/Berlin" description:"System timezone: an IANA name, the Windows timezone name, or the zone abbreviation"`
+	UTCOffsetSeconds     int           `json:"utc_offset_seconds" example:"7200" description:"Current offset of the timezone from UTC"`
+	Locale               string        `json:"locale" example:"en_US.UTF-8" description:"System locale (empty when none is configured)"`
+	Users                []SessionUser `json:"users" description:"Logged-in user sessions (Linux and macOS)"`
+	Containerized        bool          `json:"containerized" example:"true" description:"Whether the service itself runs in a container"`
+	ContainerRuntime     string        `json:"container_runtime,omitempty" example:"docker" description:"Container runtime detected for the service (omitted when not containerized)"`
+	HostRoots            HostRoots     `json:"host_roots" description:"Where host filesystems are read from"`
+}
+
+// SessionUser represents a logged-in user session
+type SessionUser struct {
+	User     string    `json:"user" example:"alice" description:"User name"`
+	Terminal string    `json:"terminal" example:"pts/0" description:"Terminal of the session"`
+	Host     string    `json:"host" example:"192.168.1.20" description:"Remote host the session came from (empty for local sessions)"`
+	Started  time.Time `json:"started" example:"2024-01-01T08:00:00Z" description:"Time the session started"`
+}
+
+// HostRoots represents where the collectors read host filesystems from
+type HostRoots struct {
+	Proc   string `json:"proc" example:"/host/proc" description:"procfs root"`
+	Sys    string `json:"sys" example:"/host/sys" description:"sysfs root"`
+	Etc    string `json:"etc" example:"/host/etc" description:"Configuration directory, used for the OS release and hostname"`
+	Run    string `json:"run" example:"/host/run" description:"Runtime state directory, used for udev device data and logged-in users"`
+	Cgroup string `json:"cgroup" example:"/sys/fs/cgroup" description:"cgroup filesystem root"`
 }
 
 // Location represents location information
@@ -61,13 +171,69 @@ type Location struct {
 }
 
 // Memory represents memory information
-// @Description Memory information including total, used, free, available, and usage percentage
+// @Description Memory information including total, used, free, available, usage percentage, swap, paging rates, and pressure
 type Memory struct {
 	Total       string `json:"total" example:"16GB" description:"Total memory"`
 	Used        string `json:"used" example:"8GB" description:"Used memory"`
 	Free        string `json:"free" example:"4GB" description:"Free memory"`
 	Available   string `json:"available" example:"12GB" description:"Available memory"`
 	UsedPercent string `json:"used_percentage" example:"50%" description:"Memory usage percentage"`
+
+	Buffers         string         `json:"buffers" example:"268.4 MB" description:"Kernel buffers (Linux)"`
+	Cached          string         `json:"cached" example:"4.3 GB" description:"Page cache"`
+	Shared          string         `json:"shared" example:"536.9 MB" description:"Shared memory (tmpfs, shm)"`
+	Slab            string         `json:"slab" example:"402.7 MB" description:"Kernel slab allocations (Linux)"`
+	SwapTotal       string         `json:"swap_total" example:"8.6 GB" description:"Total swap"`
+	SwapUsed        string         `json:"swap_used" example:"1.1 GB" description:"Used swap"`
+	SwapUsedPercent string         `json:"swap_used_percentage" example:"12.5%" description:"Swap usage percentage"`
+	SwapIn          string         `json:"swap_in" example:"41.0 KB/s" description:"Rate swapped in (Linux)"`
+	SwapOut         string         `json:"swap_out" example:"81.9 KB/s" description:"Rate swapped out (Linux)"`
+	MajorFaults     string         `json:"major_faults" example:"12.0/s" description:"Major page faults per second (Linux)"`
+	Pressure        *PressureStall `json:"pressure,omitempty" description:"Pressure stall information (Linux 4.20+ with PSI enabled)"`
+}
+
+// PressureStall represents formatted Linux pressure stall information for CPU, memory, and I/O
+// @Description Share of time tasks were stalled waiting for CPU, memory, or I/O
+type PressureStall struct {
+	CPU    *ResourceStall `json:"cpu,omitempty" description:"CPU pressure"`
+	Memory *ResourceStall `json:"memory,omitempty" description:"Memory pressure"`
+	IO     *ResourceStall `json:"io,omitempty" description:"I/O pressure"`
+}
+
+// ResourceStall represents the formatted stall lines of one PSI file
+type ResourceStall struct {
+	Some StallAverages  `json:"some" description:"Time at least one task was stalled"`
+	Full *StallAverages `json:"full,omitempty" description:"Time all non-idle tasks were stalled at once"`
+}
+
+// StallAverages represents formatted stall percentages over 10s, 60s and 300s windows and the cumulative stall time
+type StallAverages struct {
+	Avg10  string `json:"avg10" example:"1.50%" description:"Share of the last 10 seconds spent stalled"`
+	Avg60  string `json:"avg60" example:"0.80%" description:"Share of the last 60 seconds spent stalled"`
+	Avg300 string `json:"avg300" example:"0.20%" description:"Share of the last 300 seconds spent stalled"`
+	Total  string `json:"total" example:"2m3.456789s" description:"Cumulative stall time"`
+}
+
+// SystemPressure represents Linux pressure stall information for CPU, memory, and I/O
+// @Description Share of time tasks were stalled waiting for CPU, memory, or I/O
+type SystemPressure struct {
+	CPU    *ResourcePressure `json:"cpu,omitempty" description:"CPU pressure"`
+	Memory *ResourcePressure `json:"memory,omitempty" description:"Memory pressure"`
+	IO     *ResourcePressure `json:"io,omitempty" description:"I/O pressure"`
+}
+
+// ResourcePressure represents the stall lines of one PSI file
+type ResourcePressure struct {
+	Some PressureAverages  `json:"some" description:"Time at least one task was stalled"`
+	Full *PressureAverages `json:"full,omitempty" description:"Time all non-idle tasks were stalled at once"`
+}
+
+// PressureAverages represents stall percentages over 10s, 60s and 300s windows and the cumulative stall time
+type PressureAverages struct {
+	Avg10       float64 `json:"avg10" example:"1.5" description:"Percentage of the last 10 seconds spent stalled"`
+	Avg60       float64 `json:"avg60" example:"0.8" description:"Percentage of the last 60 seconds spent stalled"`
+	Avg300      float64 `json:"avg300" example:"0.2" description:"Percentage of the last 300 seconds spent stalled"`
+	TotalMicros uint64  `json:"total_us" example:"123456789" description:"Cumulative stall time in microseconds"`
 }
 
 // Disk represents disk information
@@ -79,14 +245,219 @@ type Disk struct {
 	UsedPercent string `json:"used_percentage" example:"50%" description:"Disk usage percentage"`
 }
 
-// HardwareInfo represents hardware/network interface information
-// @Description Hardware/network interface information including index, MTU, name, hardware address, and flags
+// HardwareInfo represents the DMI/SMBIOS hardware inventory
+// @Description System, motherboard, BIOS, and chassis details from DMI/SMBIOS (Linux only; empty elsewhere)
 type HardwareInfo struct {
-	Index        int    `json:"index" example:"1" description:"Interface index (positive integer)"`
-	MTU          int    `json:"mtu" example:"1500" description:"Maximum transmission unit"`
-	Name         string `json:"name" example:"eth0" description:"Interface name (e.g., en0, lo0, eth0.100)"`
-	HardwareAddr string `json:"hardware_addr" example:"00:11:22:33:44:55" description:"IEEE MAC-48, EUI-48 and EUI-64 form"`
-	Flags        string `json:"flags" example:"up,broadcast,running,multicast" description:"Interface flags (e.g., FlagUp, FlagLoopback, FlagMulticast)"`
+	System  HardwareSystem  `json:"system" description:"System manufacturer and model"`
+	Board   HardwareBoard   `json:"board" description:"Motherboard"`
+	BIOS    HardwareBIOS    `json:"bios" description:"BIOS or UEFI firmware"`
+	Chassis HardwareChassis `json:"chassis" description:"Enclosure"`
+}
+
+// HardwareSystem represents the system manufacturer and model
+type HardwareSystem struct {
+	Vendor  string `json:"vendor" example:"Dell Inc." description:"System manufacturer"`
+	Product string `json:"product" example:"XPS 15 9520" description:"Product name"`
+	Version string `json:"version" example:"1.0" description:"Product version"`
+	Family  string `json:"family" example:"XPS" description:"Product family"`
+	Serial  string `json:"serial,omitempty" example:"5CG1234XYZ" description:"Serial number (omitted when unreadable, which usually requires root)"`
+}
+
+// HardwareBoard represents the motherboard
+type HardwareBoard struct {
+	Vendor  string `json:"vendor" example:"ASUSTeK COMPUTER INC." description:"Board manufacturer"`
+	Name    string `json:"name" example:"PRIME Z490-A" description:"Board model"`
+	Version string `json:"version" example:"Rev 1.xx" description:"Board revision"`
+	Serial  string `json:"serial,omitempty" example:"200412345678901" description:"Serial number (omitted when unreadable, which usually requires root)"`
+}
+
+// HardwareBIOS represents the BIOS or UEFI firmware
+type HardwareBIOS struct {
+	Vendor  string `json:"vendor" example:"American Megatrends Inc." description:"Firmware vendor"`
+	Version string `json:"version" example:"2403" description:"Firmware version"`
+	Date    string `json:"date" example:"03/12/2024" description:"Release date as reported by the firmware"`
+}
+
+// HardwareChassis represents the enclosure
+type HardwareChassis struct {
+	Type    string `json:"type" example:"Desktop" description:"SMBIOS chassis type, e.g. Desktop, Notebook, Rack Mount Chassis"`
+	Vendor  string `json:"vendor" example:"Dell Inc." description:"Chassis manufacturer"`
+	Version string `json:"version" example:"1.0" description:"Chassis version"`
+}
+
+// PCIDevice represents a device on the PCI bus
+// @Description PCI device identity, class, and the kernel driver bound to it
+type PCIDevice struct {
+	Address           string `json:"address" example:"0000:01:00.0" description:"PCI domain, bus, device, and function"`
+	VendorID          string `json:"vendor_id" example:"10de" description:"Vendor ID (hexadecimal)"`
+	DeviceID          string `json:"device_id" example:"2206" description:"Device ID (hexadecimal)"`
+	Vendor            string `json:"vendor" example:"NVIDIA Corporation" description:"Best-effort vendor name from the bundled ID subset (empty when not listed; match on vendor_id)"`
+	Device            string `json:"device" example:"GA102 [GeForce RTX 3080]" description:"Best-effort device name from the bundled ID subset (empty when not listed; match on device_id)"`
+	SubsystemVendorID string `json:"subsystem_vendor_id,omitempty" example:"1458" description:"Subsystem vendor ID of the board (hexadecimal)"`
+	SubsystemDeviceID string `json:"subsystem_device_id,omitempty" example:"403f" description:"Subsystem device ID of the board (hexadecimal)"`
+	Class             string `json:"class" example:"030000" description:"Class, subclass, and programming interface (hexadecimal)"`
+	ClassName         string `json:"class_name" example:"VGA compatible controller" description:"Best-effort class name from the bundled ID subset (empty when not listed; match on class)"`
+	Revision          string `json:"revision,omitempty" example:"a1" description:"Revision (hexadecimal)"`
+	Driver            string `json:"driver,omitempty" example:"nvidia" description:"Kernel driver bound to the device (empty when none is bound)"`
+}
+
+// USBDevice represents a device on a USB bus, including hubs
+// @Description USB device identity, class, speed, port path, and the kernel drivers bound to its interfaces
+type USBDevice struct {
+	Port         string   `json:"port" example:"1-2.1" description:"Bus number and port path, or usbN for a root hub"`
+	Bus          int      `json:"bus" example:"1" description:"Bus number"`
+	DeviceNumber int      `json:"device_number" example:"5" description:"Device number on the bus"`
+	VendorID     string   `json:"vendor_id" example:"046d" description:"Vendor ID (hexadecimal)"`
+	ProductID    string   `json:"product_id" example:"c52b" description:"Product ID (hexadecimal)"`
+	Vendor       string   `json:"vendor" example:"Logitech, Inc." description:"Best-effort vendor name from the bundled ID subset, or as reported by the device (match on vendor_id)"`
+	Product      string   `json:"product" example:"Unifying Receiver" description:"Best-effort product name from the bundled ID subset, or as reported by the device (match on product_id)"`
+	Serial       string   `json:"serial,omitempty" example:"0123456789AB" description:"Serial number reported by the device"`
+	Class        string   `json:"class" example:"03" description:"Device class, or the first interface's class when the device defines it per interface (hexadecimal)"`
+	ClassName    string   `json:"class_name" example:"Human Interface Device" description:"Best-effort class name from the bundled ID subset (empty when not listed; match on class)"`
+	USBVersion   string   `json:"usb_version" example:"2.00" description:"USB specification version the device supports"`
+	SpeedMbps    float64  `json:"speed_mbps" example:"12" description:"Negotiated speed in Mbps (0 when unknown)"`
+	Drivers      []string `json:"drivers" example:"usbhid" description:"Kernel drivers bound to the device and its interfaces"`
+}
+
+// NetworkInterface represents a network interface with its addresses, link settings, and traffic
+// @Description Network interface flags, addresses, link speed, cumulative counters, and per-second rates
+type NetworkInterface struct {
+	Name         string           `json:"name" example:"eth0" description:"Interface name"`
+	Index        int              `json:"index" example:"2" description:"Interface index"`
+	MTU          int              `json:"mtu" example:"1500" description:"Maximum transmission unit"`
+	HardwareAddr string           `json:"hardware_addr" example:"00:11:22:33:44:55" description:"MAC address (empty for virtual interfaces without one)"`
+	Flags        []string         `json:"flags" example:"up,broadcast,multicast" description:"Interface flags as reported by the operating system"`
+	Addresses    []NetworkAddress `json:"addresses" description:"IPv4 and IPv6 addresses assigned to the interface"`
+	SpeedMbps    int              `json:"speed_mbps" example:"1000" description:"Negotiated link speed in Mbps (0 when unknown or down, Linux only)"`
+	Duplex       string           `json:"duplex,omitempty" example:"full" description:"Duplex mode: full or half (Linux only)"`
+	Counters     NetworkTraffic   `json:"counters" description:"Cumulative traffic since the interface came up"`
+	Rates        NetworkRates     `json:"rates" description:"Traffic per second since the previous request (at least one second apart)"`
+}
+
+// NetworkAddress represents an IP address assigned to an interface
+type NetworkAddress struct {
+	Address string `json:"address" example:"192.168.1.10" description:"IP address"`
+	Prefix  int    `json:"prefix" example:"24" description:"Network prefix length"`
+	Family  string `json:"family" example:"ipv4" description:"Address family: ipv4 or ipv6"`
+}
+
+// NetworkTraffic represents cumulative traffic counters of an interface
+type NetworkTraffic struct {
+	BytesSent   uint64 `json:"bytes_sent" example:"123456789"`
+	BytesRecv   uint64 `json:"bytes_recv" example:"987654321"`
+	PacketsSent uint64 `json:"packets_sent" example:"123456"`
+	PacketsRecv uint64 `json:"packets_recv" example:"654321"`
+	ErrorsIn    uint64 `json:"errors_in" example:"0"`
+	ErrorsOut   uint64 `json:"errors_out" example:"0"`
+	DropsIn     uint64 `json:"drops_in" example:"12"`
+	DropsOut    uint64 `json:"drops_out" example:"0"`
+}
+
+// NetworkRates represents traffic of an interface per second
+type NetworkRates struct {
+	BytesSent   float64 `json:"bytes_sent" example:"131072"`
+	BytesRecv   float64 `json:"bytes_recv" example:"1048576"`
+	PacketsSent float64 `json:"packets_sent" example:"120"`
+	PacketsRecv float64 `json:"packets_recv" example:"850"`
+	ErrorsIn    float64 `json:"errors_in" example:"0"`
+	ErrorsOut   float64 `json:"errors_out" example:"0"`
+	DropsIn     float64 `json:"drops_in" example:"0"`
+	DropsOut    float64 `json:"drops_out" example:"0"`
+}
+
+// Sensors represents temperature, fan, and voltage readings
+// @Description Hardware sensor readings; lists are empty when the platform exposes no sensors
+type Sensors struct {
+	Temperatures []TemperatureSensor `json:"temperatures" description:"Temperature sensors"`
+	Fans         []FanSensor         `json:"fans" description:"Fan speeds (Linux hwmon)"`
+	Voltages     []VoltageSensor     `json:"voltages" description:"Voltage rails (Linux hwmon)"`
+}
+
+// TemperatureSensor represents a temperature reading with the thresholds reported by the kernel
+type TemperatureSensor struct {
+	Key      string  `json:"key" example:"coretemp_core_0" description:"Sensor chip and label"`
+	Celsius  float64 `json:"celsius" example:"54" description:"Current temperature in degrees Celsius"`
+	High     float64 `json:"high,omitempty" example:"100" description:"High threshold in degrees Celsius, when provided"`
+	Critical float64 `json:"critical,omitempty" example:"110" description:"Critical threshold in degrees Celsius, when provided"`
+}
+
+// FanSensor represents a fan speed reading
+type FanSensor struct {
+	Chip  string  `json:"chip" example:"nct6775" description:"Sensor chip name"`
+	Label string  `json:"label" example:"fan1" description:"Fan label, or the sysfs channel when unlabeled"`
+	RPM   float64 `json:"rpm" example:"1200" description:"Current speed in revolutions per minute"`
+	Min   float64 `json:"min,omitempty" example:"300" description:"Minimum speed alarm threshold, when provided"`
+	Max   float64 `json:"max,omitempty" example:"2500" description:"Maximum speed, when provided"`
+}
+
+// VoltageSensor represents a voltage rail reading
+type VoltageSensor struct {
+	Chip     string  `json:"chip" example:"nct6775" description:"Sensor chip name"`
+	Label    string  `json:"label" example:"Vcore" description:"Rail label, or the sysfs channel when unlabeled"`
+	Volts    float64 `json:"volts" example:"1.2" description:"Current voltage"`
+	Min      float64 `json:"min,omitempty" example:"0.8" description:"Minimum alarm threshold in volts, when provided"`
+	Max      float64 `json:"max,omitempty" example:"1.5" description:"Maximum alarm threshold in volts, when provided"`
+	Critical float64 `json:"critical,omitempty" example:"1.6" description:"Critical threshold in volts, when provided"`
+}
+
+// PowerStatus represents AC adapter and battery state
+// @Description AC adapter state and system batteries (Linux power_supply)
+type PowerStatus struct {
+	ACOnline  *bool     `json:"ac_online,omitempty" example:"true" description:"Whether an AC adapter is connected (omitted when the system reports no adapter)"`
+	OnBattery bool      `json:"on_battery" example:"false" description:"Whether the system is running from battery"`
+	Batteries []Battery `json:"batteries" description:"System batteries; peripheral batteries are excluded"`
+}
+
+// Battery represents the charge, rate, and health of a battery
+type Battery struct {
+	Name                 string  `json:"name" example:"BAT0" description:"Power supply name"`
+	Status               string  `json:"status" example:"Discharging" description:"Charging, Discharging, Full, Not charging, or Unknown"`
+	ChargePercent        float64 `json:"charge_percent" example:"76" description:"Remaining charge"`
+	RateWatts            float64 `json:"rate_watts" example:"-12.4" description:"Charge rate in watts, negative while discharging"`
+	EnergyWh             float64 `json:"energy_wh" example:"38.2" description:"Remaining energy in watt-hours"`
+	EnergyFullWh         float64 `json:"energy_full_wh" example:"50.1" description:"Energy when fully charged in watt-hours"`
+	EnergyDesignWh       float64 `json:"energy_design_wh" example:"57" description:"Design capacity in watt-hours"`
+	HealthPercent        float64 `json:"health_percent" example:"87.9" description:"Full capacity as a share of design capacity"`
+	CycleCount           int     `json:"cycle_count" example:"312" description:"Charge cycles (0 when not reported)"`
+	TimeRemainingSeconds int64   `json:"time_remaining_seconds,omitempty" example:"11100" description:"Estimated time to empty while discharging, or to full while charging"`
+}
+
+// Cgroup represents the resource limits and usage of the control group the process runs in
+// @Description cgroup v1 or v2 CPU, memory, and process limits with current usage (Linux only)
+type Cgroup struct {
+	Version int           `json:"version" example:"2" description:"cgroup version (1 or 2), or 0 when cgroups are unavailable"`
+	Path    string        `json:"path,omitempty" example:"/docker/3f4e2a" description:"Path of the process's cgroup in the hierarchy"`
+	CPU     *CgroupCPU    `json:"cpu,omitempty" description:"CPU bandwidth limit and usage"`
+	Memory  *CgroupMemory `json:"memory,omitempty" description:"Memory limit and usage"`
+	Pids    *CgroupPids   `json:"pids,omitempty" description:"Process count limit and usage"`
+}
+
+// CgroupCPU represents the CPU bandwidth limit and usage of a cgroup
+type CgroupCPU struct {
+	QuotaMicros      *int64  `json:"quota_us" example:"200000" description:"CPU time allowed per period in microseconds (null when unlimited)"`
+	PeriodMicros     int64   `json:"period_us" example:"100000" description:"Length of a quota period in microseconds"`
+	CpusetCPUs       int     `json:"cpuset_cpus,omitempty" example:"4" description:"Number of CPUs the cpuset allows (omitted when unknown)"`
+	EffectiveCPUs    float64 `json:"effective_cpus" example:"2" description:"CPUs available to the cgroup: the lower of quota/period and the cpuset, or every CPU"`
+	UsageSeconds     float64 `json:"usage_seconds" example:"1234.5" description:"CPU time consumed by the cgroup"`
+	Periods          uint64  `json:"periods" example:"52311" description:"Quota periods that have elapsed"`
+	ThrottledPeriods uint64  `json:"throttled_periods" example:"1204" description:"Periods in which the cgroup was throttled"`
+	ThrottledSeconds float64 `json:"throttled_seconds" example:"42.7" description:"Total time the cgroup was throttled"`
+}
+
+// CgroupMemory represents the memory limit and usage of a cgroup
+type CgroupMemory struct {
+	LimitBytes     *uint64 `json:"limit_bytes" example:"536870912" description:"Hard memory limit (null when unlimited)"`
+	SoftLimitBytes *uint64 `json:"soft_limit_bytes" example:"402653184" description:"memory.high on v2 or the soft limit on v1 (null when unlimited)"`
+	UsageBytes     uint64  `json:"usage_bytes" example:"268435456" description:"Current memory usage"`
+	PeakBytes      *uint64 `json:"peak_bytes" example:"314572800" description:"Highest memory usage recorded (null when the kernel does not track it)"`
+	OOMEvents      uint64  `json:"oom_events" example:"0" description:"Times the limit was reached and the OOM killer invoked (v1 reports kills only)"`
+	OOMKills       uint64  `json:"oom_kills" example:"0" description:"Processes killed by the OOM killer"`
+}
+
+// CgroupPids represents the process count limit and usage of a cgroup
+type CgroupPids struct {
+	Limit   *uint64 `json:"limit" example:"4096" description:"Maximum number of processes (null when unlimited)"`
+	Current uint64  `json:"current" example:"12" description:"Current number of processes"`
 }
 
 // APIResponse represents a standard API response
@@ -129,6 +500,45 @@ type LocationInfo = Location
 // @Description Memory information
 type MemoryInfo = Memory
 
+// DiskPartition represents space and inode usage of a single mounted filesystem
+// @Description Mounted filesystem with space and inode usage in bytes and counts
+type DiskPartition struct {
+	Device            string   `json:"device" example:"/dev/nvme0n1p2" description:"Block device or volume"`
+	Mountpoint        string   `json:"mountpoint" example:"/" description:"Mount path"`
+	Fstype            string   `json:"fstype" example:"ext4" description:"Filesystem type"`
+	Options           []string `json:"options" example:"rw,relatime" description:"Mount options"`
+	ReadOnly          bool     `json:"read_only" example:"false" description:"Whether the filesystem is mounted read-only"`
+	Total             uint64   `json:"total" example:"512110190592" description:"Total space in bytes"`
+	Used              uint64   `json:"used" example:"256055095296" description:"Used space in bytes"`
+	Free              uint64   `json:"free" example:"256055095296" description:"Space available to unprivileged users in bytes"`
+	UsedPercent       float64  `json:"used_percent" example:"50" description:"Share of space used"`
+	InodesTotal       uint64   `json:"inodes_total" example:"31260672" description:"Total inodes (0 when the filesystem does not report them)"`
+	InodesUsed        uint64   `json:"inodes_used" example:"1048576" description:"Used inodes"`
+	InodesFree        uint64   `json:"inodes_free" example:"30212096" description:"Free inodes"`
+	InodesUsedPercent float64  `json:"inodes_used_percent" example:"3.4" description:"Share of inodes used"`
+}
+
+// DiskIO represents block device throughput over a sampling window
+// @Description Per-device disk I/O rates computed from counter deltas
+type DiskIO struct {
+	SampledAt time.Time      `json:"sampled_at" example:"2024-01-01T03:00:00Z" description:"End of the sampling window"`
+	Interval  float64        `json:"interval_seconds" example:"1.0" description:"Length of the sampling window in seconds"`
+	Devices   []DiskIODevice `json:"devices" description:"Rates per block device, sorted by name"`
+}
+
+// DiskIODevice represents I/O rates of a single block device
+// @Description Throughput, IOPS, latency, and utilization of a block device
+type DiskIODevice struct {
+	Name               string  `json:"name" example:"nvme0n1" description:"Block device name"`
+	ReadBytesPerSec    float64 `json:"read_bytes_per_sec" example:"1048576" description:"Bytes read per second"`
+	WriteBytesPerSec   float64 `json:"write_bytes_per_sec" example:"524288" description:"Bytes written per second"`
+	ReadIOPS           float64 `json:"read_iops" example:"120" description:"Read operations per second"`
+	WriteIOPS          float64 `json:"write_iops" example:"45" description:"Write operations per second"`
+	ReadLatencyMs      float64 `json:"read_latency_ms" example:"0.4" description:"Average time per completed read in milliseconds"`
+	WriteLatencyMs     float64 `json:"write_latency_ms" example:"1.2" description:"Average time per completed write in milliseconds"`
+	UtilizationPercent float64 `json:"utilization_percent" example:"12.5" description:"Share of the window the device was busy"`
+}
+
 // DiskInfo represents disk information (alias for Disk)
 // @Description Disk information
 type DiskInfo = Disk
@@ -136,8 +546,53 @@ type DiskInfo = Disk
 // UsagePercentages represents usage percentages for various system components
 // @Description Usage percentages for CPU, GPU, memory, and disk
 type UsagePercentages struct {
-	CPU    string `json:"cpu_usage" example:"45.2%" description:"CPU usage percentage"`
-	GPU    string `json:"gpu_usage" example:"30%" description:"GPU usage percentage"`
-	Memory string `json:"memory_usage" example:"50%" description:"Memory usage percentage"`
-	Disk   string `json:"disk_usage" example:"75%" description:"Disk usage percentage"`
+	CPU        string `json:"cpu_usage" example:"45.2%" description:"CPU usage percentage"`
+	GPU        string `json:"gpu_usage" example:"30%" description:"GPU usage percentage"`
+	Memory     string `json:"memory_usage" example:"50%" description:"Memory usage percentage"`
+	Disk       string `json:"disk_usage" example:"75%" description:"Disk usage percentage"`
+	RelativeTo string `json:"relative_to" example:"host" description:"What CPU and memory usage are relative to: host, or cgroup for the process's cgroup limits"`
+}
+
+// UsageSample represents a numeric point-in-time usage measurement
+// @Description Numeric usage percentages for CPU, GPU, memory, and disk at a point in time
+type UsageSample struct {
+	Timestamp time.Time `json:"timestamp" example:"2024-01-01T03:00:00Z" description:"Time the sample was taken"`
+	Window    float64   `json:"window_seconds" example:"1" description:"Seconds the usage was averaged over"`
+	CPU       float64   `json:"cpu" example:"45.2" description:"CPU usage percentage"`
+	GPU       float64   `json:"gpu" example:"30" description:"GPU usage percentage"`
+	Memory    float64   `json:"memory" example:"50" description:"Memory usage percentage"`
+	Disk      float64   `json:"disk" example:"75" description:"Disk usage percentage"`
+	DiskRead  float64   `json:"disk_read_bps" example:"1048576" description:"Bytes read per second across all block devices"`
+	DiskWrite float64   `json:"disk_write_bps" example:"524288" description:"Bytes written per second across all block devices"`
+}
+
+// UsageHistory represents bucketed usage samples over a time range
+// @Description Usage history averaged into fixed-size time buckets
+type UsageHistory struct {
+	From   time.Time    `json:"from" example:"2024-01-01T00:00:00Z" description:"Start of the requested range (inclusive)"`
+	To     time.Time    `json:"to" example:"2024-01-01T06:00:00Z" description:"End of the requested range (exclusive)"`
+	Step   int64        `json:"step" example:"300" description:"Bucket size in seconds"`
+	Series []UsagePoint `json:"series" description:"Averaged usage per bucket, oldest first"`
+}
+
+// UsagePoint represents averaged usage over a single history bucket
+// @Description Averaged usage percentages over one history bucket
+type UsagePoint struct {
+	Timestamp time.Time `json:"timestamp" example:"2024-01-01T03:00:00Z" description:"Start of the bucket"`
+	CPU       float64   `json:"cpu" example:"45.2" description:"Average CPU usage percentage"`
+	GPU       float64   `json:"gpu" example:"30" description:"Average GPU usage percentage"`
+	Memory    float64   `json:"memory" example:"50" description:"Average memory usage percentage"`
+	Disk      float64   `json:"disk" example:"75" description:"Average disk usage percentage"`
+	DiskRead  float64   `json:"disk_read_bps" example:"1048576" description:"Average bytes read per second"`
+	DiskWrite float64   `json:"disk_write_bps" example:"524288" description:"Average bytes written per second"`
+	Samples   int       `json:"samples" example:"5" description:"Number of samples in the bucket"`
+}
+
+// UsageAlert represents a usage threshold being crossed
+// @Description Raised when a usage percentage rises above its configured threshold
+type UsageAlert struct {
+	Metric    string    `json:"metric" example:"cpu" description:"Metric that crossed its threshold (cpu, gpu, memory, disk)"`
+	Value     float64   `json:"value" example:"97.5" description:"Usage percentage that triggered the alert"`
+	Threshold float64   `json:"threshold" example:"90" description:"Configured threshold percentage"`
+	Timestamp time.Time `json:"timestamp" example:"2024-01-01T03:00:00Z" description:"Time of the sample that triggered the alert"`
 }
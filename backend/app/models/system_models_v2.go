@@ -0,0 +1,74 @@
+package models
+
+// API v2 models carry raw numbers. Field names end in their unit (_bytes, _percent, _mhz,
+// _bytes_per_sec, _per_sec, _seconds) so that ?format=human can render them generically.
+
+// CPUV2 represents CPU information with numeric values
+// @Description CPU model, topology, cache size in bytes, frequency in MHz, and usage percentages
+type CPUV2 struct {
+	Model                 string             `json:"model" example:"Intel Core i7-10700K" description:"CPU model name"`
+	PhysicalCores         int                `json:"physical_cores" example:"8" description:"Number of physical cores across all sockets"`
+	LogicalCores          int                `json:"logical_cores" example:"16" description:"Number of logical processors (hardware threads)"`
+	Sockets               int                `json:"sockets" example:"1" description:"Number of physical CPU packages"`
+	CacheBytes            uint64             `json:"cache_bytes" example:"16777216" description:"CPU cache size in bytes"`
+	FrequencyMHz          float64            `json:"frequency_mhz" example:"3800" description:"Nominal CPU frequency in MHz"`
+	UsagePercent          float64            `json:"usage_percent" example:"45.2" description:"Current CPU usage"`
+	PerCoreUsagePercent   []float64          `json:"per_core_usage_percent" example:"12.5,40.1" description:"Usage of each logical processor"`
+	Times                 CPUTimePercentages `json:"times" description:"Share of CPU time spent in each mode"`
+	LoadAverage           LoadAverage        `json:"load_average" description:"1, 5 and 15 minute load averages"`
+	ContextSwitchesPerSec float64            `json:"context_switches_per_sec" example:"15234" description:"Context switches per second (Linux only)"`
+	InterruptsPerSec      float64            `json:"interrupts_per_sec" example:"8421" description:"Interrupts per second (Linux only)"`
+}
+
+// GPUV2 represents GPU information with numeric values
+// @Description GPU name, driver, video memory in bytes, usage percentage, and clock in MHz
+type GPUV2 struct {
+	Name         string   `json:"name" example:"NVIDIA GeForce RTX 3080" description:"GPU model name"`
+	Driver       string   `json:"driver" example:"nvidia 535.104.05" description:"GPU driver and version"`
+	PCIAddress   string   `json:"pci_address,omitempty" example:"0000:01:00.0" description:"PCI slot of the GPU (Linux only)"`
+	VRAMBytes    uint64   `json:"vram_bytes" example:"10737418240" description:"Video memory in bytes (0 when unknown)"`
+	UsagePercent *float64 `json:"usage_percent" example:"30" description:"Current GPU usage (null when unknown)"`
+	ClockMHz     float64  `json:"clock_mhz" example:"1800" description:"Current GPU clock in MHz (0 when unknown)"`
+}
+
+// MemoryV2 represents memory information with numeric values
+// @Description Memory and swap sizes in bytes, usage percentages, paging rates, and pressure
+type MemoryV2 struct {
+	TotalBytes         uint64          `json:"total_bytes" example:"17179869184" description:"Total memory"`
+	UsedBytes          uint64          `json:"used_bytes" example:"8589934592" description:"Used memory"`
+	FreeBytes          uint64          `json:"free_bytes" example:"4294967296" description:"Free memory"`
+	AvailableBytes     uint64          `json:"available_bytes" example:"12884901888" description:"Memory available for new allocations"`
+	UsedPercent        float64         `json:"used_percent" example:"50" description:"Share of memory used"`
+	BuffersBytes       uint64          `json:"buffers_bytes" example:"268435456" description:"Kernel buffers (Linux)"`
+	CachedBytes        uint64          `json:"cached_bytes" example:"4294967296" description:"Page cache"`
+	SharedBytes        uint64          `json:"shared_bytes" example:"536870912" description:"Shared memory (tmpfs, shm)"`
+	SlabBytes          uint64          `json:"slab_bytes" example:"402653184" description:"Kernel slab allocations (Linux)"`
+	SwapTotalBytes     uint64          `json:"swap_total_bytes" example:"8589934592" description:"Total swap"`
+	SwapUsedBytes      uint64          `json:"swap_used_bytes" example:"1073741824" description:"Used swap"`
+	SwapUsedPercent    float64         `json:"swap_used_percent" example:"12.5" description:"Share of swap used"`
+	SwapInBytesPerSec  float64         `json:"swap_in_bytes_per_sec" example:"40960" description:"Bytes swapped in per second (Linux)"`
+	SwapOutBytesPerSec float64         `json:"swap_out_bytes_per_sec" example:"81920" description:"Bytes swapped out per second (Linux)"`
+	MajorFaultsPerSec  float64         `json:"major_faults_per_sec" example:"12" description:"Major page faults per second (Linux)"`
+	Pressure           *SystemPressure `json:"pressure,omitempty" description:"Pressure stall information (Linux 4.20+ with PSI enabled)"`
+}
+
+// DiskV2 represents total disk space with numeric values
+// @Description Disk space in bytes across all devices, counting each device once
+type DiskV2 struct {
+	TotalBytes  uint64  `json:"total_bytes" example:"1000204886016" description:"Total disk space"`
+	UsedBytes   uint64  `json:"used_bytes" example:"500102443008" description:"Used disk space"`
+	FreeBytes   uint64  `json:"free_bytes" example:"500102443008" description:"Free disk space"`
+	UsedPercent float64 `json:"used_percent" example:"50" description:"Share of disk space used"`
+}
+
+// UsageV2 represents current usage with numeric values
+// @Description Current CPU, GPU, memory, and disk usage percentages and disk throughput
+type UsageV2 struct {
+	WindowSeconds        float64 `json:"window_seconds" example:"1" description:"Seconds the usage was averaged over"`
+	CPUPercent           float64 `json:"cpu_percent" example:"45.2" description:"CPU usage"`
+	GPUPercent           float64 `json:"gpu_percent" example:"30" description:"Usage of the first GPU (0 when unknown)"`
+	MemoryPercent        float64 `json:"memory_percent" example:"50" description:"Memory usage"`
+	DiskPercent          float64 `json:"disk_percent" example:"75" description:"Disk space usage"`
+	DiskReadBytesPerSec  float64 `json:"disk_read_bytes_per_sec" example:"1048576" description:"Bytes read per second across all block devices"`
+	DiskWriteBytesPerSec float64 `json:"disk_write_bytes_per_sec" example:"524288" description:"Bytes written per second across all block devices"`
+}
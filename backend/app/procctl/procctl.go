@@ -0,0 +1,416 @@
+package procctl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/database"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+const (
+	// ConfirmationTTL is how long a confirmation token stays valid
+	ConfirmationTTL = time.Minute
+	// DefaultWaitTimeout is how long WaitForExit waits when no timeout is given
+	DefaultWaitTimeout = 10 * time.Second
+	// MaxWaitTimeout is the longest WaitForExit may wait
+	MaxWaitTimeout = time.Minute
+	// DefaultAuditLimit is the number of audit entries returned when no limit is given
+	DefaultAuditLimit = 100
+
+	// ActionRenice changes the nice value of a process
+	ActionRenice = "renice"
+	// ActionWait waits for a process to exit
+	ActionWait = "wait"
+
+	// SourceAPI marks actions requested over the REST API
+	SourceAPI = "api"
+	// SourceDesktop marks actions requested from the desktop app
+	SourceDesktop = "desktop"
+
+	waitPollInterval = 100 * time.Millisecond
+)
+
+// Audit outcomes
+const (
+	outcomeSucceeded = "succeeded"
+	outcomeFailed    = "failed"
+	outcomeRefused   = "refused"
+)
+
+// ErrProtectedProcess is returned for PID 1, kernel threads and the application itself
+var ErrProtectedProcess = errors.New("process is protected")
+
+// ErrInvalidConfirmation is returned when a token is missing, expired or bound to a different action
+var ErrInvalidConfirmation = errors.New("invalid or expired confirmation token")
+
+// ErrInvalidAction is returned for unknown signals and out-of-range nice values
+var ErrInvalidAction = errors.New("invalid process action")
+
+// ErrHostProcRoot is returned when processes are listed from a mounted host /proc. Their PIDs belong
+// to the host's PID namespace while signals go to ours, so an action could hit a different process.
+var ErrHostProcRoot = errors.New("process control is unavailable with a host proc root")
+
+// signals maps supported signal names to the gopsutil call that delivers them
+var signals = map[string]func(p *process.Process) error{
+	"SIGTERM": (*process.Process).Terminate,
+	"SIGKILL": (*process.Process).Kill,
+	"SIGSTOP": (*process.Process).Suspend,
+	"SIGCONT": (*process.Process).Resume,
+}
+
+// confirmation is a pending token and the exact action it authorizes
+type confirmation struct {
+	pid        int32
+	createTime int64
+	action     string
+	nice       int
+	expiresAt  time.Time
+}
+
+// ProcessControlService signals and renices processes behind confirmation tokens and an audit log
+type ProcessControlService struct {
+	db            *database.DB
+	logger        *log.Logger
+	mutex         sync.Mutex
+	confirmations map[string]*confirmation
+}
+
+// NewProcessControlService creates a new process control service. procRoot is the procfs root
+// processes are listed from; anything but the default is refused with ErrHostProcRoot.
+func NewProcessControlService(db *database.DB, procRoot string) (*ProcessControlService, error) {
+	if path.Clean(procRoot) != utils.DefaultProcRoot {
+		return nil, fmt.Errorf("%w: %s", ErrHostProcRoot, procRoot)
+	}
+
+	return &ProcessControlService{
+		db:            db,
+		logger:        log.New(os.Stdout, "[PROCCTL] ", log.LstdFlags),
+		confirmations: make(map[string]*confirmation),
+	}, nil
+}
+
+// RequestConfirmation issues a single-use token authorizing action on pid.
+// nice is only used when action is renice.
+func (s *ProcessControlService) RequestConfirmation(pid int32, action string, nice int) (*models.ProcessConfirmation, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	action, err := normalizeAction(action, nice)
+	if err != nil {
+		return nil, err
+	}
+
+	p, name, createTime, err := s.openProcess(pid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProtected(p); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate confirmation token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+	expiresAt := time.Now().Add(ConfirmationTTL)
+
+	s.mutex.Lock()
+	s.pruneConfirmations()
+	s.confirmations[token] = &confirmation{
+		pid:        pid,
+		createTime: createTime,
+		action:     action,
+		nice:       nice,
+		expiresAt:  expiresAt,
+	}
+	s.mutex.Unlock()
+
+	result := &models.ProcessConfirmation{
+		Token:     token,
+		PID:       pid,
+		Name:      name,
+		Action:    action,
+		ExpiresAt: expiresAt,
+	}
+	if action == ActionRenice {
+		result.Nice = nice
+	}
+	return result, nil
+}
+
+// Signal sends SIGTERM, SIGKILL, SIGSTOP or SIGCONT to a process
+func (s *ProcessControlService) Signal(pid int32, signal, token, source string) (*models.ProcessActionResult, error) {
+	action, err := normalizeAction(signal, 0)
+	if err == nil && action == ActionRenice {
+		err = fmt.Errorf("%w: %q is not a signal", ErrInvalidAction, signal)
+	}
+	if err != nil {
+		action = signal
+	}
+
+	return s.perform(pid, action, 0, token, source, err, func(p *process.Process) error {
+		return signals[action](p)
+	})
+}
+
+// Renice changes the nice value of a process
+func (s *ProcessControlService) Renice(pid int32, nice int, token, source string) (*models.ProcessActionResult, error) {
+	_, err := normalizeAction(ActionRenice, nice)
+
+	return s.perform(pid, ActionRenice, nice, token, source, err, func(p *process.Process) error {
+		return setNice(p.Pid, nice)
+	})
+}
+
+// WaitForExit polls until a process exits, the timeout elapses, or ctx is done
+func (s *ProcessControlService) WaitForExit(ctx context.Context, pid int32, timeout time.Duration, source string) (*models.ProcessWaitResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	if timeout > MaxWaitTimeout {
+		timeout = MaxWaitTimeout
+	}
+
+	start := time.Now()
+	p, name, _, err := s.openProcess(pid)
+	if err != nil && !errors.Is(err, services.ErrProcessNotFound) {
+		return nil, err
+	}
+
+	// A process that is already gone, e.g. after SIGKILL, counts as exited
+	exited := p == nil || !isAlive(p)
+	deadline := start.Add(timeout)
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+	for !exited && time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			// The caller is gone, e.g. an HTTP client disconnected, so stop polling for it
+			s.audit(pid, name, ActionWait, fmt.Sprintf("timeout %s, canceled", timeout), outcomeFailed, ctx.Err(), source)
+			return nil, fmt.Errorf("wait for process canceled: %w", ctx.Err())
+		case <-ticker.C:
+		}
+		exited = !isAlive(p)
+	}
+
+	result := &models.ProcessWaitResult{
+		PID:      pid,
+		Exited:   exited,
+		WaitedMs: time.Since(start).Milliseconds(),
+	}
+
+	detail := fmt.Sprintf("timeout %s, exited %t", timeout, exited)
+	s.audit(pid, name, ActionWait, detail, outcomeSucceeded, nil, source)
+	return result, nil
+}
+
+// ListAudit retrieves the most recent process actions, newest first
+func (s *ProcessControlService) ListAudit(limit int) ([]*database.ProcessAudit, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	if limit <= 0 {
+		limit = DefaultAuditLimit
+	}
+	return s.db.GetProcessAudits(limit)
+}
+
+// perform checks the token and protections, runs the action, and records the attempt.
+// invalid is a validation error found by the caller, which is audited as a refusal.
+func (s *ProcessControlService) perform(pid int32, action string, nice int, token, source string, invalid error,
+	run func(p *process.Process) error) (*models.ProcessActionResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var detail string
+	if action == ActionRenice {
+		detail = fmt.Sprintf("nice %d", nice)
+	}
+
+	p, name, createTime, err := s.openProcess(pid)
+	if err != nil {
+		s.audit(pid, "", action, detail, outcomeRefused, err, source)
+		return nil, err
+	}
+
+	if invalid != nil {
+		s.audit(pid, name, action, detail, outcomeRefused, invalid, source)
+		return nil, invalid
+	}
+
+	if err := checkProtected(p); err != nil {
+		s.audit(pid, name, action, detail, outcomeRefused, err, source)
+		return nil, err
+	}
+
+	if err := s.redeem(token, pid, createTime, action, nice); err != nil {
+		s.audit(pid, name, action, detail, outcomeRefused, err, source)
+		return nil, err
+	}
+
+	if err := run(p); err != nil {
+		err = fmt.Errorf("failed to %s process %d: %w", strings.ToLower(action), pid, err)
+		s.audit(pid, name, action, detail, outcomeFailed, err, source)
+		return nil, err
+	}
+
+	s.audit(pid, name, action, detail, outcomeSucceeded, nil, source)
+	return &models.ProcessActionResult{
+		PID:         pid,
+		Name:        name,
+		Action:      action,
+		PerformedAt: time.Now(),
+	}, nil
+}
+
+// redeem consumes a token if it authorizes exactly this action on this process
+func (s *ProcessControlService) redeem(token string, pid int32, createTime int64, action string, nice int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c, ok := s.confirmations[token]
+	if !ok {
+		return ErrInvalidConfirmation
+	}
+	delete(s.confirmations, token)
+
+	if time.Now().After(c.expiresAt) {
+		return ErrInvalidConfirmation
+	}
+	// A different create time means the PID now belongs to another process
+	if c.pid != pid || c.createTime != createTime || c.action != action || c.nice != nice {
+		return fmt.Errorf("%w: token was issued for a different action", ErrInvalidConfirmation)
+	}
+	return nil
+}
+
+// pruneConfirmations drops expired tokens; the caller must hold the mutex
+func (s *ProcessControlService) pruneConfirmations() {
+	now := time.Now()
+	for token, c := range s.confirmations {
+		if now.After(c.expiresAt) {
+			delete(s.confirmations, token)
+		}
+	}
+}
+
+// openProcess opens a process and reads the identity used to bind tokens
+func (s *ProcessControlService) openProcess(pid int32) (*process.Process, string, int64, error) {
+	p, err := process.NewProcess(pid)
+	if err != nil {
+		if errors.Is(err, process.ErrorProcessNotRunning) {
+			return nil, "", 0, fmt.Errorf("%w: %d", services.ErrProcessNotFound, pid)
+		}
+		return nil, "", 0, fmt.Errorf("failed to open process %d: %w", pid, err)
+	}
+
+	createTime, err := p.CreateTime()
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("failed to read process %d: %w", pid, err)
+	}
+	name, _ := p.Name()
+
+	return p, name, createTime, nil
+}
+
+// audit records an action attempt, logging instead of failing when the write fails
+func (s *ProcessControlService) audit(pid int32, name, action, detail, outcome string, actionErr error, source string) {
+	entry := &database.ProcessAudit{
+		PerformedAt: time.Now(),
+		PID:         pid,
+		ProcessName: name,
+		Action:      action,
+		Detail:      detail,
+		Outcome:     outcome,
+		Source:      source,
+	}
+	if actionErr != nil {
+		entry.Error = actionErr.Error()
+	}
+
+	if err := s.db.AddProcessAudit(entry); err != nil {
+		s.logger.Printf("Failed to audit %s on process %d: %v", action, pid, err)
+	}
+}
+
+// normalizeAction validates an action name, accepting signals with or without the SIG prefix
+func normalizeAction(action string, nice int) (string, error) {
+	if strings.EqualFold(action, ActionRenice) {
+		if nice < -20 || nice > 19 {
+			return "", fmt.Errorf("%w: nice must be between -20 and 19, got %d", ErrInvalidAction, nice)
+		}
+		return ActionRenice, nil
+	}
+
+	signal := strings.ToUpper(action)
+	if !strings.HasPrefix(signal, "SIG") {
+		signal = "SIG" + signal
+	}
+	if _, ok := signals[signal]; !ok {
+		return "", fmt.Errorf("%w: unknown action %q (use SIGTERM, SIGKILL, SIGSTOP, SIGCONT or renice)", ErrInvalidAction, action)
+	}
+	return signal, nil
+}
+
+// checkProtected refuses PID 1, kernel threads and the application itself
+func checkProtected(p *process.Process) error {
+	if p.Pid <= 1 {
+		return fmt.Errorf("%w: refusing to act on PID %d", ErrProtectedProcess, p.Pid)
+	}
+	if int(p.Pid) == os.Getpid() {
+		return fmt.Errorf("%w: refusing to act on the application itself", ErrProtectedProcess)
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		// Kernel threads are kthreadd (PID 2) and its children
+		if p.Pid == 2 {
+			return fmt.Errorf("%w: refusing to act on kernel thread %d", ErrProtectedProcess, p.Pid)
+		}
+		if ppid, err := p.Ppid(); err == nil && ppid == 2 {
+			return fmt.Errorf("%w: refusing to act on kernel thread %d", ErrProtectedProcess, p.Pid)
+		}
+	case "windows":
+		// PID 4 is the System process that hosts kernel threads
+		if p.Pid == 4 {
+			return fmt.Errorf("%w: refusing to act on the System process", ErrProtectedProcess)
+		}
+	}
+
+	return nil
+}
+
+// isAlive reports whether a process is still running, treating zombies as exited
+func isAlive(p *process.Process) bool {
+	running, err := p.IsRunning()
+	if err != nil || !running {
+		return false
+	}
+	if status, err := p.Status(); err == nil && len(status) > 0 && status[0] == process.Zombie {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,216 @@
+package procctl
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/database"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// sleeperEnv makes the test binary sleep instead of running tests, giving tests a process to act on
+const sleeperEnv = "PROCCTL_TEST_SLEEPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(sleeperEnv) == "1" {
+		time.Sleep(time.Minute)
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestNewProcessControlServiceRefusesHostProcRoot(t *testing.T) {
+	tests := []struct {
+		procRoot string
+		wantErr  bool
+	}{
+		{procRoot: "/proc"},
+		{procRoot: "/proc/"},
+		{procRoot: "/host/proc", wantErr: true},
+		{procRoot: "/host/proc/../../proc"},
+		{procRoot: "/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procRoot, func(t *testing.T) {
+			service, err := NewProcessControlService(nil, tt.procRoot)
+			if tt.wantErr {
+				if !errors.Is(err, ErrHostProcRoot) || service != nil {
+					t.Fatalf("NewProcessControlService(%q) = %v, %v, want ErrHostProcRoot", tt.procRoot, service, err)
+				}
+				return
+			}
+			if err != nil || service == nil {
+				t.Fatalf("NewProcessControlService(%q) error = %v", tt.procRoot, err)
+			}
+		})
+	}
+}
+
+func TestRedeemBindsTokenToAction(t *testing.T) {
+	issued := confirmation{pid: 42, createTime: 1000, action: ActionRenice, nice: 5}
+
+	tests := []struct {
+		name       string
+		token      string
+		pid        int32
+		createTime int64
+		action     string
+		nice       int
+		expired    bool
+		wantErr    bool
+	}{
+		{name: "matching", token: "token", pid: 42, createTime: 1000, action: ActionRenice, nice: 5},
+		{name: "unknown token", token: "other", pid: 42, createTime: 1000, action: ActionRenice, nice: 5, wantErr: true},
+		{name: "different process", token: "token", pid: 43, createTime: 1000, action: ActionRenice, nice: 5, wantErr: true},
+		{name: "reused PID", token: "token", pid: 42, createTime: 2000, action: ActionRenice, nice: 5, wantErr: true},
+		{name: "different action", token: "token", pid: 42, createTime: 1000, action: "SIGKILL", nice: 5, wantErr: true},
+		{name: "different nice value", token: "token", pid: 42, createTime: 1000, action: ActionRenice, nice: 19, wantErr: true},
+		{name: "expired", token: "token", pid: 42, createTime: 1000, action: ActionRenice, nice: 5, expired: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewProcessControlService(nil, "/proc")
+			if err != nil {
+				t.Fatalf("NewProcessControlService() error = %v", err)
+			}
+			c := issued
+			c.expiresAt = time.Now().Add(ConfirmationTTL)
+			if tt.expired {
+				c.expiresAt = time.Now().Add(-time.Second)
+			}
+			service.confirmations["token"] = &c
+
+			err = service.redeem(tt.token, tt.pid, tt.createTime, tt.action, tt.nice)
+			if tt.wantErr != (err != nil) || (err != nil && !errors.Is(err, ErrInvalidConfirmation)) {
+				t.Fatalf("redeem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// Tokens are single use, whether or not they matched
+			if tt.token == "token" {
+				if err := service.redeem("token", 42, 1000, ActionRenice, 5); !errors.Is(err, ErrInvalidConfirmation) {
+					t.Errorf("second redeem() error = %v, want ErrInvalidConfirmation", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckProtected(t *testing.T) {
+	child := startSleeper(t)
+
+	tests := []struct {
+		name    string
+		pid     int32
+		wantErr bool
+	}{
+		{name: "init", pid: 1, wantErr: true},
+		{name: "application", pid: int32(os.Getpid()), wantErr: true},
+		{name: "child", pid: child},
+		// kthreadd on Linux; an ordinary PID elsewhere
+		{name: "PID 2", pid: 2, wantErr: runtime.GOOS == "linux"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkProtected(&process.Process{Pid: tt.pid})
+			if tt.wantErr != (err != nil) || (err != nil && !errors.Is(err, ErrProtectedProcess)) {
+				t.Errorf("checkProtected(%d) error = %v, wantErr %v", tt.pid, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignalRequiresMatchingConfirmation(t *testing.T) {
+	service := newTestService(t)
+	pid := startSleeper(t)
+
+	if _, err := service.RequestConfirmation(int32(os.Getpid()), "SIGTERM", 0); !errors.Is(err, ErrProtectedProcess) {
+		t.Fatalf("RequestConfirmation() for the application error = %v, want ErrProtectedProcess", err)
+	}
+
+	confirm := func(action string) string {
+		t.Helper()
+		confirmation, err := service.RequestConfirmation(pid, action, 0)
+		if err != nil {
+			t.Fatalf("RequestConfirmation(%q) error = %v", action, err)
+		}
+		return confirmation.Token
+	}
+
+	// A token for SIGSTOP does not authorize SIGKILL, and is spent by the attempt
+	token := confirm("stop")
+	if _, err := service.Signal(pid, "SIGKILL", token, SourceAPI); !errors.Is(err, ErrInvalidConfirmation) {
+		t.Fatalf("Signal() with a token for another action error = %v, want ErrInvalidConfirmation", err)
+	}
+	if _, err := service.Signal(pid, "SIGSTOP", token, SourceAPI); !errors.Is(err, ErrInvalidConfirmation) {
+		t.Fatalf("Signal() with a spent token error = %v, want ErrInvalidConfirmation", err)
+	}
+
+	result, err := service.Signal(pid, "term", confirm("SIGTERM"), SourceAPI)
+	if err != nil {
+		t.Fatalf("Signal() error = %v", err)
+	}
+	if result.PID != pid || result.Action != "SIGTERM" {
+		t.Errorf("Signal() = %+v, want SIGTERM on %d", result, pid)
+	}
+
+	entries, err := service.ListAudit(0)
+	if err != nil {
+		t.Fatalf("ListAudit() error = %v", err)
+	}
+	var outcomes []string
+	for _, entry := range entries {
+		outcomes = append(outcomes, entry.Action+" "+entry.Outcome)
+	}
+	want := []string{"SIGTERM succeeded", "SIGSTOP refused", "SIGKILL refused"}
+	if len(outcomes) != len(want) {
+		t.Fatalf("ListAudit() = %v, want %v", outcomes, want)
+	}
+	for i := range want {
+		if outcomes[i] != want[i] {
+			t.Fatalf("ListAudit() = %v, want %v", outcomes, want)
+		}
+	}
+}
+
+// newTestService creates a service whose audit database lives in a temporary home directory
+func newTestService(t *testing.T) *ProcessControlService {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	db, err := database.NewDB()
+	if err != nil {
+		t.Fatalf("NewDB() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	service, err := NewProcessControlService(db, "/proc")
+	if err != nil {
+		t.Fatalf("NewProcessControlService() error = %v", err)
+	}
+	return service
+}
+
+// startSleeper starts a copy of the test binary that sleeps until it is signalled or the test ends
+func startSleeper(t *testing.T) int32 {
+	t.Helper()
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), sleeperEnv+"=1")
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start sleeper: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	return int32(cmd.Process.Pid)
+}
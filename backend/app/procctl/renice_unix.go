@@ -0,0 +1,10 @@
+//go:build !windows
+
+package procctl
+
+import "syscall"
+
+// setNice sets the nice value of a process
+func setNice(pid int32, nice int) error {
+	return syscall.Setpriority(syscall.PRIO_PROCESS, int(pid), nice)
+}
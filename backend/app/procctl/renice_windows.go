@@ -0,0 +1,31 @@
+//go:build windows
+
+package procctl
+
+import "golang.org/x/sys/windows"
+
+// setNice maps a nice value onto the closest Windows priority class.
+// Realtime priority is never used.
+func setNice(pid int32, nice int) error {
+	var class uint32
+	switch {
+	case nice <= -10:
+		class = windows.HIGH_PRIORITY_CLASS
+	case nice < 0:
+		class = windows.ABOVE_NORMAL_PRIORITY_CLASS
+	case nice == 0:
+		class = windows.NORMAL_PRIORITY_CLASS
+	case nice < 10:
+		class = windows.BELOW_NORMAL_PRIORITY_CLASS
+	default:
+		class = windows.IDLE_PRIORITY_CLASS
+	}
+
+	handle, err := windows.OpenProcess(windows.PROCESS_SET_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(handle)
+
+	return windows.SetPriorityClass(handle, class)
+}
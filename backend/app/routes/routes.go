@@ -1,10 +1,19 @@
 package routes
 
 import (
+	"context"
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
 	"github.com/kishansakhiya/wails-demo/backend/app/controllers"
 	"github.com/kishansakhiya/wails-demo/backend/app/database"
+	"github.com/kishansakhiya/wails-demo/backend/app/history"
+	"github.com/kishansakhiya/wails-demo/backend/app/live"
+	"github.com/kishansakhiya/wails-demo/backend/app/metrics"
 	"github.com/kishansakhiya/wails-demo/backend/app/middleware"
+	"github.com/kishansakhiya/wails-demo/backend/app/procctl"
 	"github.com/kishansakhiya/wails-demo/backend/app/scheduler"
+	"github.com/kishansakhiya/wails-demo/backend/app/services"
+	"github.com/kishansakhiya/wails-demo/backend/app/watcher"
+	"log"
 	"net/http"
 	"time"
 
@@ -13,10 +22,41 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
+// processControlPaths are the process action routes, which never get CORS headers
+var processControlPaths = []string{
+	"/api/v1/processes/audit",
+	"/api/v1/processes/*/confirm",
+	"/api/v1/processes/*/signal",
+	"/api/v1/processes/*/renice",
+	"/api/v1/processes/*/wait",
+}
+
 // SetupRoutes configures all API routes
-func SetupRoutes(r *gin.Engine) {
-	// Create controller instance
-	systemController := controllers.NewSystemController()
+func SetupRoutes(r *gin.Engine, cfg *config.Config) {
+	// Add global middleware before any route, since gin only applies it to routes registered later
+	// Process actions stay same-origin; browsers on other sites get no CORS grant for them
+	r.Use(middleware.CORS(processControlPaths...))
+	r.Use(middleware.RequestLogger())
+	r.Use(middleware.Recovery())
+	if cfg.Metrics.Enabled {
+		r.Use(middleware.Metrics())
+	}
+
+	// Share one system service so stateful collectors see every request
+	systemService := services.NewSystemService(cfg)
+	go systemService.StartSampler(context.Background())
+
+	// Create controller instances
+	systemController := controllers.NewSystemController(systemService)
+	systemV2Controller := controllers.NewSystemV2Controller(systemService)
+	processController := controllers.NewProcessController(systemService)
+	streamController := controllers.NewStreamController(
+		live.NewUsageBroadcaster(systemService, cfg.Live),
+		cfg.Live.MaxClients,
+	)
+
+	var historyController *controllers.HistoryController
+	var processControlController *controllers.ProcessControlController
 
 	// Initialize database and scheduler service for schedule endpoints
 	db, err := database.NewDB()
@@ -24,9 +64,12 @@ func SetupRoutes(r *gin.Engine) {
 		// Log error but continue without schedule endpoints
 		// In production, you might want to handle this differently
 	} else {
-		schedulerService := scheduler.NewSchedulerService(db)
+		schedulerService := scheduler.NewSchedulerService(db, systemService)
 		scheduleController := controllers.NewScheduleController(schedulerService)
 
+		// Keep system tasks in sync, which also holds and resumes AC-only schedules as the power source changes
+		go watcher.NewWatcherService(schedulerService, time.Minute).StartWatcher(context.Background())
+
 		// Schedule endpoints
 		r.POST("/api/v1/schedules", scheduleController.AddSchedule)
 		r.GET("/api/v1/schedules", scheduleController.ListSchedules)
@@ -35,16 +78,34 @@ func SetupRoutes(r *gin.Engine) {
 		r.DELETE("/api/v1/schedules/:id", scheduleController.DeleteSchedule)
 		r.PATCH("/api/v1/schedules/:id/toggle", scheduleController.ToggleSchedule)
 		r.POST("/api/v1/schedules/sync", scheduleController.SyncWithSystem)
-	}
 
-	// Add global middleware
-	r.Use(middleware.CORS())
-	r.Use(middleware.RequestLogger())
-	r.Use(middleware.Recovery())
+		// Process actions are opt-in and only available when they can be audited
+		if cfg.Process.Enabled {
+			processControl, err := procctl.NewProcessControlService(db, cfg.Host.ProcRoot)
+			if err != nil {
+				log.Printf("Process control disabled: %v", err)
+			} else {
+				processControlController = controllers.NewProcessControlController(processControl)
+			}
+		}
+
+		// Start recording usage history in the background
+		if cfg.History.Enabled {
+			historyService := history.NewHistoryService(systemService, db, cfg.History)
+			go historyService.StartSampler(context.Background())
+			historyController = controllers.NewHistoryController(historyService)
+		}
+	}
 
 	// Health check endpoint
 	r.GET("/health", systemController.HealthCheck)
 
+	// Prometheus metrics endpoint
+	if cfg.Metrics.Enabled {
+		metrics.Registry.MustRegister(metrics.NewSystemCollector(systemService))
+		r.GET(cfg.Metrics.Path, gin.WrapH(metrics.Handler()))
+	}
+
 	// Swagger documentation
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -64,11 +125,54 @@ func SetupRoutes(r *gin.Engine) {
 		v1.GET("/location", systemController.GetLocationInfo)
 		v1.GET("/memory", systemController.GetMemoryInfo)
 		v1.GET("/disk", systemController.GetDiskInfo)
+		v1.GET("/disk/partitions", systemController.GetDiskPartitions)
+		v1.GET("/disk/io", systemController.GetDiskIO)
 		v1.GET("/hardware", systemController.GetHardwareInfo)
+		v1.GET("/hardware/pci", systemController.GetPCIDevices)
+		v1.GET("/hardware/usb", systemController.GetUSBDevices)
+		v1.GET("/network", systemController.GetNetworkInterfaces)
+		v1.GET("/sensors", systemController.GetSensors)
+		v1.GET("/power", systemController.GetPowerStatus)
+		v1.GET("/cgroup", systemController.GetCgroup)
+
+		// Generic access to any registered collector
+		v1.GET("/collectors", systemController.ListCollectors)
+		v1.GET("/collectors/:name", systemController.Collect)
 		v1.GET("/usage", systemController.GetUsagePercentages)
+		v1.GET("/usage/stream", streamController.StreamUsage)
+		if historyController != nil {
+			v1.GET("/usage/history", historyController.GetUsageHistory)
+		}
+		v1.GET("/processes", processController.ListProcesses)
+		v1.GET("/processes/:pid", processController.GetProcess)
+		if processControlController != nil {
+			control := v1.Group("/processes", middleware.RequireToken(cfg.Process.Token, cfg.Process.Origins))
+			control.GET("/audit", processControlController.ListAudit)
+			control.POST("/:pid/confirm", processControlController.ConfirmAction)
+			control.POST("/:pid/signal", processControlController.SignalProcess)
+			control.POST("/:pid/renice", processControlController.ReniceProcess)
+			control.POST("/:pid/wait", processControlController.WaitForExit)
+		}
 		v1.GET("/test", systemController.TestRoute)
 	}
 
+	// API v2 group: numeric values with units in the field names, ?format=human for formatted output
+	v2 := r.Group("/api/v2")
+	{
+		v2.Use(middleware.RateLimit(100, time.Minute)) // 100 requests per minute
+
+		v2.GET("/system", systemV2Controller.GetAllSystemInfo)
+		v2.GET("/cpu", systemV2Controller.GetCPUStats)
+		v2.GET("/gpu", systemV2Controller.GetGPUStats)
+		v2.GET("/memory", systemV2Controller.GetMemoryStats)
+		v2.GET("/disk", systemV2Controller.GetDiskStats)
+		v2.GET("/usage", systemV2Controller.GetUsageStats)
+
+		// Every other section is already numeric and served by its collector
+		v2.GET("/collectors", systemV2Controller.ListCollectors)
+		v2.GET("/collectors/:name", systemV2Controller.Collect)
+	}
+
 	// Add 404 handler
 	r.NoRoute(func(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{
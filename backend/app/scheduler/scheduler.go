@@ -1,24 +1,38 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"runtime"
+	"time"
 
 	"github.com/kishansakhiya/wails-demo/backend/app/database"
+	"github.com/kishansakhiya/wails-demo/backend/app/metrics"
 )
 
+// powerTimeout bounds how long reading the power state may delay a sync
+const powerTimeout = 5 * time.Second
+
+// PowerSource reports whether the system is running from battery
+type PowerSource interface {
+	OnBattery(ctx context.Context) (bool, error)
+}
+
 // SchedulerService handles system-level scheduling operations
 type SchedulerService struct {
 	db     *database.DB
+	power  PowerSource
 	logger *log.Logger
 }
 
-// NewSchedulerService creates a new scheduler service
-func NewSchedulerService(db *database.DB) *SchedulerService {
+// NewSchedulerService creates a new scheduler service.
+// power may be nil, in which case schedules that require AC power are always kept active.
+func NewSchedulerService(db *database.DB, power PowerSource) *SchedulerService {
 	return &SchedulerService{
 		db:     db,
+		power:  power,
 		logger: log.New(os.Stdout, "[SCHEDULER] ", log.LstdFlags),
 	}
 }
@@ -42,7 +56,7 @@ func (s *SchedulerService) AddSchedule(schedule *database.Schedule) error {
 
 	// Create system tasks if enabled
 	if schedule.Enabled {
-		if err := s.createSystemTasks(schedule); err != nil {
+		if err := s.activateSystemTasks(schedule); err != nil {
 			s.logger.Printf("Warning: Failed to create system tasks for schedule %d: %v", schedule.ID, err)
 			// Don't fail the operation, just log the warning
 		}
@@ -97,7 +111,7 @@ func (s *SchedulerService) UpdateSchedule(schedule *database.Schedule) error {
 
 	// Create new system tasks if enabled
 	if schedule.Enabled {
-		if err := s.createSystemTasks(schedule); err != nil {
+		if err := s.activateSystemTasks(schedule); err != nil {
 			s.logger.Printf("Warning: Failed to create new system tasks: %v", err)
 		}
 	}
@@ -124,7 +138,7 @@ func (s *SchedulerService) ToggleSchedule(id int, enabled bool) error {
 	// Handle system tasks
 	if enabled {
 		// Create system tasks
-		if err := s.createSystemTasks(schedule); err != nil {
+		if err := s.activateSystemTasks(schedule); err != nil {
 			s.logger.Printf("Warning: Failed to create system tasks: %v", err)
 		}
 	} else {
@@ -138,8 +152,10 @@ func (s *SchedulerService) ToggleSchedule(id int, enabled bool) error {
 }
 
 // SyncWithSystem ensures database and system scheduler are in sync
-func (s *SchedulerService) SyncWithSystem() error {
+func (s *SchedulerService) SyncWithSystem() (err error) {
 	s.logger.Println("Syncing with system scheduler")
+	start := time.Now()
+	defer func() { metrics.ObserveSchedulerSync(time.Since(start), err) }()
 
 	// Get all enabled schedules from database
 	schedules, err := s.db.GetEnabledSchedules()
@@ -147,8 +163,21 @@ func (s *SchedulerService) SyncWithSystem() error {
 		return fmt.Errorf("failed to get enabled schedules: %w", err)
 	}
 
+	onBattery := s.onBattery()
+
 	// Check each schedule's system tasks
 	for _, schedule := range schedules {
+		// Hold AC-only schedules while on battery; they are recreated once AC power returns
+		if schedule.RequireACPower && onBattery {
+			if err := s.verifySystemTasks(schedule); err == nil {
+				s.logger.Printf("Running on battery, holding schedule %d until AC power returns", schedule.ID)
+				if err := s.removeSystemTasks(schedule); err != nil {
+					s.logger.Printf("Failed to remove system tasks for schedule %d: %v", schedule.ID, err)
+				}
+			}
+			continue
+		}
+
 		if err := s.verifySystemTasks(schedule); err != nil {
 			s.logger.Printf("Schedule %d system tasks out of sync: %v", schedule.ID, err)
 			// Recreate system tasks
@@ -161,6 +190,32 @@ func (s *SchedulerService) SyncWithSystem() error {
 	return nil
 }
 
+// activateSystemTasks creates the system tasks of an enabled schedule. Schedules that require AC power
+// are held while on battery; SyncWithSystem creates their tasks once AC power returns.
+func (s *SchedulerService) activateSystemTasks(schedule *database.Schedule) error {
+	if schedule.RequireACPower && s.onBattery() {
+		s.logger.Printf("Running on battery, holding schedule %d until AC power returns", schedule.ID)
+		return nil
+	}
+	return s.createSystemTasks(schedule)
+}
+
+// onBattery reports whether the system is running from battery, treating unknown power state as AC
+func (s *SchedulerService) onBattery() bool {
+	if s.power == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), powerTimeout)
+	defer cancel()
+
+	onBattery, err := s.power.OnBattery(ctx)
+	if err != nil {
+		s.logger.Printf("Failed to read power state: %v", err)
+		return false
+	}
+	return onBattery
+}
+
 // createSystemTasks creates system-level tasks for a schedule
 func (s *SchedulerService) createSystemTasks(schedule *database.Schedule) error {
 	switch runtime.GOOS {
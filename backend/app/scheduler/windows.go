@@ -181,10 +181,33 @@ func (s *SchedulerService) createWindowsTasks(schedule *database.Schedule) error
 		}
 	}
 
+	// Task Scheduler can hold the start task on battery by itself, which also covers power changes between syncs.
+	// The end task always runs so the app is stopped either way.
+	if err := setWindowsBatteryPolicy(baseTaskName+"_Start", schedule.RequireACPower); err != nil {
+		s.logger.Printf("Warning: Failed to set battery policy for schedule %d: %v", schedule.ID, err)
+	}
+	if err := setWindowsBatteryPolicy(baseTaskName+"_End", false); err != nil {
+		s.logger.Printf("Warning: Failed to set battery policy for schedule %d: %v", schedule.ID, err)
+	}
+
 	s.logger.Printf("Created Windows tasks for schedule %d", schedule.ID)
 	return nil
 }
 
+// setWindowsBatteryPolicy sets whether a task in the app's task folder may start, and keep running, on battery.
+// schtasks cannot set these, so the task's settings are changed through the ScheduledTasks module.
+func setWindowsBatteryPolicy(taskName string, requireACPower bool) error {
+	script := fmt.Sprintf(`$task = Get-ScheduledTask -TaskName '%s' -TaskPath '%s'; `+
+		`$task.Settings.DisallowStartIfOnBatteries = $%t; `+
+		`$task.Settings.StopIfGoingOnBatteries = $%t; `+
+		`Set-ScheduledTask -InputObject $task | Out-Null`,
+		taskName, utils.TaskFolder, requireACPower, requireACPower)
+	if err := utils.RunCommand(context.Background(), script); err != nil {
+		return fmt.Errorf("failed to update task settings: %w", err)
+	}
+	return nil
+}
+
 func (s *SchedulerService) removeWindowsTasks(schedule *database.Schedule) error {
 	baseTaskName := fmt.Sprintf("WailsDemo_Schedule_%d", schedule.ID)
 	startTaskName := fmt.Sprintf("%s%s_Start", utils.TaskFolder, baseTaskName)
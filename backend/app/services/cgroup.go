@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// ErrInvalidUsageBase is returned when usage is requested relative to an unknown or unavailable base
+var ErrInvalidUsageBase = errors.New("invalid usage base")
+
+// Bases that usage percentages can be expressed relative to
+const (
+	UsageBaseHost   = "host"
+	UsageBaseCgroup = "cgroup"
+)
+
+func init() {
+	registerCollector("cgroup", 2*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetCgroup(ctx)
+	})
+}
+
+// GetCgroup retrieves the limits and usage of the cgroup the process runs in.
+// Only Linux has cgroups; other platforms report version 0 with no controllers.
+func (s *SystemService) GetCgroup(ctx context.Context) (*models.Cgroup, error) {
+	if runtime.GOOS != "linux" {
+		return &models.Cgroup{}, nil
+	}
+	return utils.ReadCgroup(s.config.Host.ProcRoot, s.config.Host.CgroupRoot)
+}
+
+// cgroupUsage returns CPU usage as a share of the cgroup's effective CPUs and memory usage as a
+// share of its memory limit, averaged over window. Memory without a limit is relative to host memory.
+// Either value is nil when the cgroup has no matching controller.
+func (s *SystemService) cgroupUsage(ctx context.Context, window time.Duration) (*float64, *float64, error) {
+	if runtime.GOOS != "linux" {
+		return nil, nil, fmt.Errorf("%w: cgroups are only available on Linux", ErrInvalidUsageBase)
+	}
+
+	samples := s.sampler.span(window)
+	if samples == nil || samples[0].cgroup == nil || samples[len(samples)-1].cgroup == nil {
+		var err error
+		if samples, err = s.measureCgroup(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	first, last := samples[0], samples[len(samples)-1]
+
+	var cpuPercent *float64
+	if first.cgroup.CPU != nil && last.cgroup.CPU != nil && last.cgroup.CPU.EffectiveCPUs > 0 {
+		elapsed := last.at.Sub(first.at).Seconds()
+		used := last.cgroup.CPU.UsageSeconds - first.cgroup.CPU.UsageSeconds
+		if elapsed > 0 && used >= 0 {
+			percent := used / (elapsed * last.cgroup.CPU.EffectiveCPUs) * 100
+			cpuPercent = &percent
+		}
+	}
+
+	var memoryPercent *float64
+	var total float64
+	count := 0
+	for _, sample := range samples {
+		if sample.cgroup == nil || sample.cgroup.Memory == nil {
+			continue
+		}
+		limit := float64(sample.memory.Total)
+		if sample.cgroup.Memory.LimitBytes != nil {
+			limit = float64(*sample.cgroup.Memory.LimitBytes)
+		}
+		if limit > 0 {
+			total += float64(sample.cgroup.Memory.UsageBytes) / limit * 100
+			count++
+		}
+	}
+	if count > 0 {
+		percent := total / float64(count)
+		memoryPercent = &percent
+	}
+
+	return cpuPercent, memoryPercent, nil
+}
+
+// measureCgroup reads the cgroup twice, a second apart, for when the sampler has no cgroup readings
+func (s *SystemService) measureCgroup(ctx context.Context) ([]usageSample, error) {
+	ctx = s.hostContext(ctx)
+	samples := make([]usageSample, 2)
+	for i := range samples {
+		if i > 0 {
+			if err := sleepContext(ctx, time.Second); err != nil {
+				return nil, err
+			}
+		}
+
+		group, err := s.GetCgroup(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cgroup: %w", err)
+		}
+		memory, err := mem.VirtualMemoryWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get memory usage: %w", err)
+		}
+		samples[i] = usageSample{at: time.Now(), cgroup: group, memory: memory}
+	}
+	return samples, nil
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/cache"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// ErrCollectorNotFound is returned when no collector is registered under the requested name
+var ErrCollectorNotFound = errors.New("collector not found")
+
+// Collector gathers one section of system information
+type Collector interface {
+	// Name identifies the collector in routes and in the aggregate system response
+	Name() string
+	// Collect gathers the current data
+	Collect(ctx context.Context) (any, error)
+	// TTL is how long a result stays fresh; static data such as the OS can be kept far longer than usage
+	TTL() time.Duration
+}
+
+// CollectorRegistry holds the collectors of a SystemService by name
+type CollectorRegistry struct {
+	mutex      sync.RWMutex
+	collectors map[string]Collector
+
+	// Optional cache in front of every registered collector
+	cache      *cache.Cache
+	keyPrefix  string
+	defaultTTL time.Duration
+	timeout    func(name string) time.Duration
+}
+
+// NewCollectorRegistry creates an empty collector registry
+func NewCollectorRegistry() *CollectorRegistry {
+	return &CollectorRegistry{
+		collectors: make(map[string]Collector),
+	}
+}
+
+// newCachedRegistry creates a registry whose collectors are served through c.
+// keyPrefix separates registries sharing the cache; defaultTTL applies to collectors without a TTL.
+// timeout bounds each shared load, which outlives the callers that started it.
+func newCachedRegistry(c *cache.Cache, keyPrefix string, defaultTTL time.Duration, timeout func(name string) time.Duration) *CollectorRegistry {
+	registry := NewCollectorRegistry()
+	registry.cache = c
+	registry.keyPrefix = keyPrefix
+	registry.defaultTTL = defaultTTL
+	registry.timeout = timeout
+	return registry
+}
+
+// Register adds a collector, refusing names that are already taken
+func (r *CollectorRegistry) Register(collector Collector) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Collector names share the top level of the aggregate response with these fields
+	if collector.Name() == "status" || collector.Name() == "errors" {
+		return fmt.Errorf("collector name %q is reserved", collector.Name())
+	}
+	if _, exists := r.collectors[collector.Name()]; exists {
+		return fmt.Errorf("collector %q is already registered", collector.Name())
+	}
+
+	if r.cache != nil {
+		ttl := collector.TTL()
+		if ttl <= 0 {
+			ttl = r.defaultTTL
+		}
+		collector = &cachedCollector{
+			Collector: collector,
+			cache:     r.cache,
+			key:       r.keyPrefix + collector.Name(),
+			ttl:       ttl,
+			timeout:   r.timeout(collector.Name()),
+		}
+	}
+	r.collectors[collector.Name()] = collector
+	return nil
+}
+
+// Get returns the collector registered under name
+func (r *CollectorRegistry) Get(name string) (Collector, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	collector, ok := r.collectors[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCollectorNotFound, name)
+	}
+	return collector, nil
+}
+
+// All returns every registered collector sorted by name
+func (r *CollectorRegistry) All() []Collector {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	collectors := make([]Collector, 0, len(r.collectors))
+	for _, collector := range r.collectors {
+		collectors = append(collectors, collector)
+	}
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].Name() < collectors[j].Name()
+	})
+	return collectors
+}
+
+// funcCollector adapts a function to the Collector interface
+type funcCollector struct {
+	name    string
+	ttl     time.Duration
+	collect func(ctx context.Context) (any, error)
+}
+
+// NewCollector creates a collector from a function
+func NewCollector(name string, ttl time.Duration, collect func(ctx context.Context) (any, error)) Collector {
+	return &funcCollector{name: name, ttl: ttl, collect: collect}
+}
+
+func (c *funcCollector) Name() string       { return c.name }
+func (c *funcCollector) TTL() time.Duration { return c.ttl }
+
+func (c *funcCollector) Collect(ctx context.Context) (any, error) {
+	return c.collect(ctx)
+}
+
+// cachedCollector serves a collector's results from the cache while they are fresh
+type cachedCollector struct {
+	Collector
+	cache   *cache.Cache
+	key     string
+	ttl     time.Duration
+	timeout time.Duration
+}
+
+func (c *cachedCollector) TTL() time.Duration { return c.ttl }
+
+func (c *cachedCollector) Collect(ctx context.Context) (any, error) {
+	result, err := c.fetch(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// fetch returns the cached result, collecting again when it is stale or fresh is set.
+// Concurrent callers share one load, so it runs detached from the caller that started it,
+// under the collector's own deadline, and each caller stops waiting when its ctx is done.
+func (c *cachedCollector) fetch(ctx context.Context, fresh bool) (cache.Result, error) {
+	return c.cache.Fetch(ctx, c.key, c.ttl, fresh, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+		return c.Collector.Collect(loadCtx)
+	})
+}
+
+// runCollector runs a collector under its configured deadline, going through its cache when it has one
+func (s *SystemService) runCollector(ctx context.Context, collector Collector, fresh bool) (cache.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.collectorTimeout(collector.Name()))
+	defer cancel()
+
+	return fetchCollector(ctx, collector, fresh)
+}
+
+// collectorTimeout returns how long the named collector may run before it is cancelled
+func (s *SystemService) collectorTimeout(name string) time.Duration {
+	if seconds, ok := s.config.Collector.Timeouts[name]; ok {
+		return time.Duration(seconds) * time.Second
+	}
+	return time.Duration(s.config.Collector.Timeout) * time.Second
+}
+
+// fetchCollector runs a collector, going through its cache when it has one.
+// Uncached results have no TTL, so they are reported as immediately stale.
+func fetchCollector(ctx context.Context, collector Collector, fresh bool) (cache.Result, error) {
+	if cached, ok := collector.(*cachedCollector); ok {
+		return cached.fetch(ctx, fresh)
+	}
+
+	value, err := collector.Collect(ctx)
+	if err != nil {
+		return cache.Result{}, err
+	}
+	return cache.Result{Value: value, StoredAt: time.Now()}, nil
+}
+
+// builtinCollector is a collector registered by this package and bound to each SystemService
+type builtinCollector struct {
+	name    string
+	ttl     time.Duration
+	collect func(s *SystemService, ctx context.Context) (any, error)
+}
+
+var builtinCollectors = map[string]builtinCollector{}
+
+// builtinCollectorsV2 holds the v2 variants of built-in collectors whose v1 model formats values as strings
+var builtinCollectorsV2 = map[string]func(s *SystemService, ctx context.Context) (any, error){}
+
+// registerCollector registers a built-in collector from an init function.
+// Every SystemService created afterwards exposes it in the aggregate response, the generic
+// collector route, and the App.Collect binding.
+func registerCollector(name string, ttl time.Duration, collect func(s *SystemService, ctx context.Context) (any, error)) {
+	if _, exists := builtinCollectors[name]; exists {
+		panic("services: collector registered twice: " + name)
+	}
+	builtinCollectors[name] = builtinCollector{name: name, ttl: ttl, collect: collect}
+}
+
+// registerCollectorV2 registers the /api/v2 variant of a built-in collector from an init function.
+// The TTL is shared with the v1 registration; collectors without a variant serve the same model in both versions.
+func registerCollectorV2(name string, collect func(s *SystemService, ctx context.Context) (any, error)) {
+	if _, exists := builtinCollectorsV2[name]; exists {
+		panic("services: v2 collector registered twice: " + name)
+	}
+	builtinCollectorsV2[name] = collect
+}
+
+// newBuiltinRegistry binds the built-in collectors to s, using their v2 variants when v2 is set.
+// Results are cached when the cache is enabled in the configuration.
+func newBuiltinRegistry(s *SystemService, v2 bool) *CollectorRegistry {
+	registry := NewCollectorRegistry()
+	if s.cache != nil {
+		keyPrefix := "v1:"
+		if v2 {
+			keyPrefix = "v2:"
+		}
+		registry = newCachedRegistry(s.cache, keyPrefix, time.Duration(s.config.Cache.TTL)*time.Second, s.collectorTimeout)
+	}
+	for _, builtin := range builtinCollectors {
+		collect := builtin.collect
+		if variant, ok := builtinCollectorsV2[builtin.name]; ok && v2 {
+			collect = variant
+		}
+		// Names are unique in builtinCollectors, so Register cannot fail here
+		_ = registry.Register(NewCollector(builtin.name, builtin.ttl, func(ctx context.Context) (any, error) {
+			return collect(s, ctx)
+		}))
+	}
+	return registry
+}
+
+// Section error codes reported in the aggregate system response
+const (
+	SectionErrorTimeout   = "timeout"
+	SectionErrorCanceled  = "canceled"
+	SectionErrorNetwork   = "network_error"
+	SectionErrorCollector = "collector_error"
+)
+
+// newSectionError classifies a collector error for the aggregate system response
+func newSectionError(err error, duration time.Duration) models.SectionError {
+	code := SectionErrorCollector
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = SectionErrorTimeout
+	case errors.Is(err, context.Canceled):
+		code = SectionErrorCanceled
+	case errors.As(err, &netErr):
+		code = SectionErrorNetwork
+	}
+
+	return models.SectionError{
+		Code:       code,
+		Message:    err.Error(),
+		DurationMs: float64(duration.Microseconds()) / 1000,
+	}
+}
+
+// Collectors returns the collector registry, which also accepts custom collectors
+func (s *SystemService) Collectors() *CollectorRegistry {
+	return s.collectors
+}
+
+// ListCollectors describes every registered collector
+func (s *SystemService) ListCollectors() []models.CollectorInfo {
+	collectors := s.collectors.All()
+	infos := make([]models.CollectorInfo, 0, len(collectors))
+	for _, collector := range collectors {
+		infos = append(infos, models.CollectorInfo{
+			Name:       collector.Name(),
+			TTLSeconds: collector.TTL().Seconds(),
+		})
+	}
+	return infos
+}
+
+// Collect runs the collector registered under name
+func (s *SystemService) Collect(ctx context.Context, name string) (any, error) {
+	result, err := s.CollectResult(ctx, name, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// CollectResult runs the collector registered under name and reports how fresh its result is.
+// With fresh set any cached result is bypassed and replaced.
+func (s *SystemService) CollectResult(ctx context.Context, name string, fresh bool) (cache.Result, error) {
+	collector, err := s.collectors.Get(name)
+	if err != nil {
+		return cache.Result{}, err
+	}
+	return s.runCollector(ctx, collector, fresh)
+}
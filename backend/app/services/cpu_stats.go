@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/load"
+)
+
+// cpuSampleWindow is how long CPU activity is measured for usage and rates
+const cpuSampleWindow = time.Second
+
+// cpuActivity represents CPU usage, time breakdown and event rates measured over a window
+type cpuActivity struct {
+	usage        float64
+	perCoreUsage []float64
+	times        models.CPUTimePercentages
+	ctxSwitches  float64
+	interrupts   float64
+}
+
+// cpuReading represents cumulative CPU times and kernel counters at one moment
+type cpuReading struct {
+	at       time.Time
+	total    []cpu.TimesStat
+	perCore  []cpu.TimesStat
+	counters *utils.ProcStatCounters // nil where /proc/stat is unavailable
+}
+
+// readCPU reads CPU times and the kernel counters under procRoot
+func readCPU(ctx context.Context, procRoot string) (*cpuReading, error) {
+	total, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	perCore, err := cpu.TimesWithContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	// Kernel counters are only available on Linux; rates stay zero elsewhere
+	counters, _ := utils.ReadProcStatCounters(procRoot)
+
+	return &cpuReading{
+		at:       time.Now(),
+		total:    total,
+		perCore:  perCore,
+		counters: counters,
+	}, nil
+}
+
+// currentCPUActivity returns CPU activity over the last sampler interval, measuring directly when the sampler is not running
+func (s *SystemService) currentCPUActivity(ctx context.Context) (*cpuActivity, error) {
+	ctx = s.hostContext(ctx)
+	if samples := s.sampler.span(0); samples != nil {
+		return cpuActivityBetween(samples[0].cpu, samples[len(samples)-1].cpu), nil
+	}
+	return measureCPUActivity(ctx, s.config.Host.ProcRoot)
+}
+
+// measureCPUActivity samples CPU times and kernel counters under procRoot twice, cpuSampleWindow apart
+func measureCPUActivity(ctx context.Context, procRoot string) (*cpuActivity, error) {
+	before, err := readCPU(ctx, procRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sleepContext(ctx, cpuSampleWindow); err != nil {
+		return nil, err
+	}
+
+	after, err := readCPU(ctx, procRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return cpuActivityBetween(before, after), nil
+}
+
+// cpuActivityBetween computes CPU usage, time breakdown and event rates between two readings
+func cpuActivityBetween(before, after *cpuReading) *cpuActivity {
+	activity := &cpuActivity{}
+	if len(before.total) > 0 && len(after.total) > 0 {
+		activity.usage = busyPercent(before.total[0], after.total[0])
+		activity.times = timePercentages(before.total[0], after.total[0])
+	}
+
+	if len(before.perCore) == len(after.perCore) {
+		activity.perCoreUsage = make([]float64, len(after.perCore))
+		for i := range after.perCore {
+			activity.perCoreUsage[i] = busyPercent(before.perCore[i], after.perCore[i])
+		}
+	}
+
+	elapsed := after.at.Sub(before.at).Seconds()
+	if before.counters != nil && after.counters != nil && elapsed > 0 {
+		activity.ctxSwitches = float64(counterDelta(before.counters.ContextSwitches, after.counters.ContextSwitches)) / elapsed
+		activity.interrupts = float64(counterDelta(before.counters.Interrupts, after.counters.Interrupts)) / elapsed
+	}
+
+	return activity
+}
+
+// cpuTotal sums the time spent in every mode. Guest time is already included in user time.
+func cpuTotal(t cpu.TimesStat) float64 {
+	return t.User + t.System + t.Idle + t.Nice + t.Iowait + t.Irq + t.Softirq + t.Steal
+}
+
+// busyPercent returns the share of time not spent idle or waiting for I/O between two readings
+func busyPercent(before, after cpu.TimesStat) float64 {
+	total := cpuTotal(after) - cpuTotal(before)
+	if total <= 0 {
+		return 0
+	}
+	idle := (after.Idle + after.Iowait) - (before.Idle + before.Iowait)
+	return clampPercent((total - idle) / total * 100)
+}
+
+// timePercentages returns the share of time spent in each mode between two readings
+func timePercentages(before, after cpu.TimesStat) models.CPUTimePercentages {
+	total := cpuTotal(after) - cpuTotal(before)
+	if total <= 0 {
+		return models.CPUTimePercentages{}
+	}
+	share := func(a, b float64) float64 {
+		return clampPercent((b - a) / total * 100)
+	}
+
+	return models.CPUTimePercentages{
+		User:    share(before.User, after.User),
+		System:  share(before.System, after.System),
+		Idle:    share(before.Idle, after.Idle),
+		Nice:    share(before.Nice, after.Nice),
+		IOWait:  share(before.Iowait, after.Iowait),
+		IRQ:     share(before.Irq, after.Irq),
+		SoftIRQ: share(before.Softirq, after.Softirq),
+		Steal:   share(before.Steal, after.Steal),
+	}
+}
+
+// countSockets returns the number of physical CPU packages
+func countSockets(info []cpu.InfoStat) int {
+	// Windows and macOS report one entry per package; Linux reports one per logical CPU
+	if runtime.GOOS != "linux" {
+		return len(info)
+	}
+
+	packages := make(map[string]struct{})
+	for _, i := range info {
+		packages[i.PhysicalID] = struct{}{}
+	}
+	return len(packages)
+}
+
+// loadAverage returns the 1, 5 and 15 minute load averages, or zeros where unsupported
+func loadAverage(ctx context.Context) models.LoadAverage {
+	avg, err := load.AvgWithContext(ctx)
+	if err != nil {
+		return models.LoadAverage{}
+	}
+	return models.LoadAverage{
+		Load1:  avg.Load1,
+		Load5:  avg.Load5,
+		Load15: avg.Load15,
+	}
+}
+
+// clampPercent limits rounding noise to the 0-100 range
+func clampPercent(value float64) float64 {
+	if value < 0 {
+		return 0
+	}
+	if value > 100 {
+		return 100
+	}
+	return value
+}
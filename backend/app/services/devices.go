@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+)
+
+func init() {
+	registerCollector("pci", 10*time.Minute, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetPCIDevices(ctx)
+	})
+	// USB devices come and go, so they are cached briefly
+	registerCollector("usb", 10*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetUSBDevices(ctx)
+	})
+}
+
+// GetPCIDevices lists the devices on the PCI bus.
+// Only Linux exposes the PCI bus in sysfs; other platforms report no devices.
+func (s *SystemService) GetPCIDevices(ctx context.Context) ([]models.PCIDevice, error) {
+	if runtime.GOOS != "linux" {
+		return []models.PCIDevice{}, nil
+	}
+	return utils.ReadPCIDevices(s.config.Host.SysRoot)
+}
+
+// GetUSBDevices lists the devices on the USB buses, root hubs included.
+// Only Linux exposes the USB buses in sysfs; other platforms report no devices.
+func (s *SystemService) GetUSBDevices(ctx context.Context) ([]models.USBDevice, error) {
+	if runtime.GOOS != "linux" {
+		return []models.USBDevice{}, nil
+	}
+	return utils.ReadUSBDevices(s.config.Host.SysRoot)
+}
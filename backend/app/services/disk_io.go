@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+func init() {
+	registerCollector("disk_io", time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetDiskIO(ctx)
+	})
+}
+
+// minDiskIOWindow is the shortest window rates are computed over; more frequent requests reuse the last result
+const minDiskIOWindow = time.Second
+
+// GetDiskIO retrieves per-device throughput, IOPS, latency and utilization over the last sampler interval.
+// Without a running sampler rates cover the time since the previous call, and the first call measures over a one second window.
+func (s *SystemService) GetDiskIO(ctx context.Context) (*models.DiskIO, error) {
+	ctx = s.hostContext(ctx)
+	if samples := s.sampler.span(0); samples != nil {
+		first, last := samples[0], samples[len(samples)-1]
+		if first.diskIO != nil && last.diskIO != nil {
+			return diskIOBetween(first.diskIO, last.diskIO, first.at, last.at), nil
+		}
+	}
+
+	s.diskIOMutex.Lock()
+	defer s.diskIOMutex.Unlock()
+
+	if s.diskIOLast != nil && time.Since(s.diskIOSampledAt) < minDiskIOWindow {
+		return s.diskIOLast, nil
+	}
+
+	previous, previousAt := s.diskIOCounters, s.diskIOSampledAt
+	if previous == nil {
+		counters, err := disk.IOCountersWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get disk I/O counters: %w", err)
+		}
+		previous, previousAt = counters, time.Now()
+		if err := sleepContext(ctx, minDiskIOWindow); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk I/O counters: %w", err)
+	}
+	now := time.Now()
+	result := diskIOBetween(previous, current, previousAt, now)
+
+	s.diskIOCounters = current
+	s.diskIOSampledAt = now
+	s.diskIOLast = result
+
+	return result, nil
+}
+
+// diskIOBetween computes per-device rates between two counter readings
+func diskIOBetween(previous, current map[string]disk.IOCountersStat, previousAt, now time.Time) *models.DiskIO {
+	result := &models.DiskIO{
+		SampledAt: now,
+		Interval:  now.Sub(previousAt).Seconds(),
+		Devices:   make([]models.DiskIODevice, 0, len(current)),
+	}
+	for name, counters := range current {
+		if ignoreBlockDevice(name) {
+			continue
+		}
+		// Devices that appeared during the window have no baseline yet
+		before, ok := previous[name]
+		if !ok {
+			continue
+		}
+		result.Devices = append(result.Devices, diskIORates(name, before, counters, result.Interval))
+	}
+	sort.Slice(result.Devices, func(i, j int) bool {
+		return result.Devices[i].Name < result.Devices[j].Name
+	})
+
+	return result
+}
+
+// diskIOTotals sums read and write bytes per second across all block devices
+func (s *SystemService) diskIOTotals(ctx context.Context) (float64, float64, error) {
+	io, err := s.GetDiskIO(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var read, write float64
+	for _, device := range io.Devices {
+		read += device.ReadBytesPerSec
+		write += device.WriteBytesPerSec
+	}
+	return read, write, nil
+}
+
+// diskIORates computes rates for one device from two counter readings seconds apart
+func diskIORates(name string, before, after disk.IOCountersStat, seconds float64) models.DiskIODevice {
+	device := models.DiskIODevice{Name: name}
+	if seconds <= 0 {
+		return device
+	}
+
+	reads := counterDelta(before.ReadCount, after.ReadCount)
+	writes := counterDelta(before.WriteCount, after.WriteCount)
+
+	device.ReadBytesPerSec = float64(counterDelta(before.ReadBytes, after.ReadBytes)) / seconds
+	device.WriteBytesPerSec = float64(counterDelta(before.WriteBytes, after.WriteBytes)) / seconds
+	device.ReadIOPS = float64(reads) / seconds
+	device.WriteIOPS = float64(writes) / seconds
+
+	if reads > 0 {
+		device.ReadLatencyMs = float64(counterDelta(before.ReadTime, after.ReadTime)) / float64(reads)
+	}
+	if writes > 0 {
+		device.WriteLatencyMs = float64(counterDelta(before.WriteTime, after.WriteTime)) / float64(writes)
+	}
+
+	busyMs := float64(counterDelta(before.IoTime, after.IoTime))
+	device.UtilizationPercent = clampPercent(busyMs / (seconds * 1000) * 100)
+
+	return device
+}
+
+// counterDelta returns the increase of a counter, or zero if it was reset
+func counterDelta(before, after uint64) uint64 {
+	if after < before {
+		return 0
+	}
+	return after - before
+}
+
+// ignoreBlockDevice skips loop and RAM disks, which mirror I/O already counted elsewhere
+func ignoreBlockDevice(name string) bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+	return strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram")
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+func init() {
+	registerCollector("disk_partitions", 30*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetDiskPartitions(ctx)
+	})
+}
+
+// GetDiskPartitions retrieves space and inode usage for every mounted filesystem that passes the disk filters
+func (s *SystemService) GetDiskPartitions(ctx context.Context) ([]models.DiskPartition, error) {
+	return s.diskPartitions(ctx)
+}
+
+// diskPartitions lists mounted filesystems that pass the configured filters
+func (s *SystemService) diskPartitions(ctx context.Context) ([]models.DiskPartition, error) {
+	ctx = s.hostContext(ctx)
+	partitions, err := disk.PartitionsWithContext(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk partitions: %w", err)
+	}
+
+	result := make([]models.DiskPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		if !s.includePartition(partition) {
+			continue
+		}
+
+		// Unreachable network mounts and pseudo filesystems can fail; skip them
+		usage, err := disk.UsageWithContext(ctx, partition.Mountpoint)
+		if err != nil || usage.Total == 0 {
+			continue
+		}
+
+		result = append(result, models.DiskPartition{
+			Device:            partition.Device,
+			Mountpoint:        partition.Mountpoint,
+			Fstype:            partition.Fstype,
+			Options:           partition.Opts,
+			ReadOnly:          hasMountOption(partition.Opts, "ro"),
+			Total:             usage.Total,
+			Used:              usage.Used,
+			Free:              usage.Free,
+			UsedPercent:       usage.UsedPercent,
+			InodesTotal:       usage.InodesTotal,
+			InodesUsed:        usage.InodesUsed,
+			InodesFree:        usage.InodesFree,
+			InodesUsedPercent: usage.InodesUsedPercent,
+		})
+	}
+
+	return result, nil
+}
+
+// includePartition applies the fstype and mountpoint include/exclude filters
+func (s *SystemService) includePartition(partition disk.PartitionStat) bool {
+	filters := s.config.Disk
+
+	if len(filters.IncludeFstypes) > 0 && !containsFold(filters.IncludeFstypes, partition.Fstype) {
+		return false
+	}
+	if containsFold(filters.ExcludeFstypes, partition.Fstype) {
+		return false
+	}
+	if len(filters.IncludeMountpoints) > 0 && !matchesAny(filters.IncludeMountpoints, partition.Mountpoint) {
+		return false
+	}
+	if matchesAny(filters.ExcludeMountpoints, partition.Mountpoint) {
+		return false
+	}
+
+	return true
+}
+
+// uniqueDevices keeps the first mount of each device so bind mounts and subvolumes are counted once
+func uniqueDevices(partitions []models.DiskPartition) []models.DiskPartition {
+	seen := make(map[string]bool, len(partitions))
+	result := make([]models.DiskPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		key := partition.Device
+		// Pseudo devices such as "overlay" or "none" name a filesystem type, not a volume
+		if !strings.HasPrefix(key, "/") && !strings.HasPrefix(key, `\\`) {
+			key = partition.Mountpoint
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, partition)
+	}
+	return result
+}
+
+// containsFold reports whether list contains value, ignoring case
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny reports whether value matches any of the glob patterns
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMountOption reports whether a mount option is set
+func hasMountOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
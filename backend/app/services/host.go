@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/common"
+)
+
+// hostContext points gopsutil calls made with the returned context at the configured host roots,
+// so they read the same host as our own procfs and sysfs readers
+func (s *SystemService) hostContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, common.EnvKey, common.EnvMap{
+		common.HostProcEnvKey: s.config.Host.ProcRoot,
+		common.HostSysEnvKey:  s.config.Host.SysRoot,
+		common.HostEtcEnvKey:  s.config.Host.EtcRoot,
+		common.HostRunEnvKey:  s.config.Host.RunRoot,
+		// gopsutil reads utmp from <HOST_VAR>/run/utmp; /var/run links to /run, so the run root's parent serves
+		common.HostVarEnvKey: filepath.Dir(s.config.Host.RunRoot),
+	})
+}
+
+// hostRoots describes the configured host roots
+func (s *SystemService) hostRoots() models.HostRoots {
+	return models.HostRoots{
+		Proc:   s.config.Host.ProcRoot,
+		Sys:    s.config.Host.SysRoot,
+		Etc:    s.config.Host.EtcRoot,
+		Run:    s.config.Host.RunRoot,
+		Cgroup: s.config.Host.CgroupRoot,
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+)
+
+// minPagingWindow is the shortest window paging rates are computed over; more frequent requests reuse the last rates
+const minPagingWindow = time.Second
+
+// pagingRates represents swap traffic in bytes and major faults per second
+type pagingRates struct {
+	swapIn      float64
+	swapOut     float64
+	majorFaults float64
+}
+
+// measurePaging computes swap and major fault rates from vmstat counters against the previous reading.
+// The first call measures over a one second window.
+func (s *SystemService) measurePaging(ctx context.Context) (*pagingRates, error) {
+	s.pagingMutex.Lock()
+	defer s.pagingMutex.Unlock()
+
+	if s.pagingLast != nil && time.Since(s.pagingSampledAt) < minPagingWindow {
+		return s.pagingLast, nil
+	}
+
+	procRoot := s.config.Host.ProcRoot
+	previous, previousAt := s.pagingCounters, s.pagingSampledAt
+	if previous == nil {
+		counters, err := utils.ReadVMStat(procRoot)
+		if err != nil {
+			return nil, err
+		}
+		previous, previousAt = counters, time.Now()
+		if err := sleepContext(ctx, minPagingWindow); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := utils.ReadVMStat(procRoot)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	rates := &pagingRates{}
+	if seconds := now.Sub(previousAt).Seconds(); seconds > 0 {
+		// vmstat counts swap traffic in pages
+		pageSize := float64(os.Getpagesize())
+		rates.swapIn = float64(counterDelta(previous.SwapIn, current.SwapIn)) * pageSize / seconds
+		rates.swapOut = float64(counterDelta(previous.SwapOut, current.SwapOut)) * pageSize / seconds
+		rates.majorFaults = float64(counterDelta(previous.MajorFaults, current.MajorFaults)) / seconds
+	}
+
+	s.pagingCounters = current
+	s.pagingSampledAt = now
+	s.pagingLast = rates
+
+	return rates, nil
+}
+
+// readSystemPressure reads CPU, memory and I/O pressure, returning nil when PSI is unavailable
+func readSystemPressure(procRoot string) *models.SystemPressure {
+	pressure := &models.SystemPressure{}
+	pressure.CPU, _ = utils.ReadPressure(procRoot, "cpu")
+	pressure.Memory, _ = utils.ReadPressure(procRoot, "memory")
+	pressure.IO, _ = utils.ReadPressure(procRoot, "io")
+
+	if pressure.CPU == nil && pressure.Memory == nil && pressure.IO == nil {
+		return nil
+	}
+	return pressure
+}
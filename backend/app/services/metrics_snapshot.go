@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// GetMetricsSnapshot gathers raw numeric readings for metrics exposition.
+// Sections that fail are left nil or empty and reported in the returned error, so
+// callers can still use whatever was collected.
+func (s *SystemService) GetMetricsSnapshot(ctx context.Context) (*models.MetricsSnapshot, error) {
+	ctx = s.hostContext(ctx)
+	snapshot := &models.MetricsSnapshot{}
+	var errs []error
+
+	// Usage since the previous snapshot; the first call measures since startup
+	if usage, err := cpu.PercentWithContext(ctx, 0, false); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get CPU usage: %w", err))
+	} else if len(usage) > 0 {
+		snapshot.CPUUsage = &usage[0]
+	}
+
+	if usage, err := cpu.PercentWithContext(ctx, 0, true); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get per-core CPU usage: %w", err))
+	} else {
+		snapshot.CPUCoreUsage = usage
+	}
+
+	if times, err := cpu.TimesWithContext(ctx, true); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get CPU times: %w", err))
+	} else {
+		for _, t := range times {
+			snapshot.CPUTimes = append(snapshot.CPUTimes, models.CPUTimes{
+				CPU:     t.CPU,
+				User:    t.User,
+				System:  t.System,
+				Idle:    t.Idle,
+				Nice:    t.Nice,
+				IOWait:  t.Iowait,
+				IRQ:     t.Irq,
+				SoftIRQ: t.Softirq,
+				Steal:   t.Steal,
+			})
+		}
+	}
+
+	if memory, err := mem.VirtualMemoryWithContext(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get memory info: %w", err))
+	} else {
+		snapshot.Memory = &models.MemoryStats{
+			Total:     memory.Total,
+			Used:      memory.Used,
+			Free:      memory.Free,
+			Available: memory.Available,
+		}
+	}
+
+	if swap, err := mem.SwapMemoryWithContext(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get swap info: %w", err))
+	} else {
+		snapshot.Swap = &models.SwapStats{
+			Total: swap.Total,
+			Used:  swap.Used,
+			Free:  swap.Free,
+		}
+	}
+
+	if disks, err := s.diskUsages(ctx); err != nil {
+		errs = append(errs, err)
+	} else {
+		snapshot.Disks = disks
+	}
+
+	if counters, err := net.IOCountersWithContext(ctx, true); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get network counters: %w", err))
+	} else {
+		for _, c := range counters {
+			snapshot.Network = append(snapshot.Network, models.NetworkCounters{
+				Name:        c.Name,
+				BytesSent:   c.BytesSent,
+				BytesRecv:   c.BytesRecv,
+				PacketsSent: c.PacketsSent,
+				PacketsRecv: c.PacketsRecv,
+				ErrorsIn:    c.Errin,
+				ErrorsOut:   c.Errout,
+				DropsIn:     c.Dropin,
+				DropsOut:    c.Dropout,
+			})
+		}
+	}
+
+	if gpus, err := s.latestGPUs(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get GPU info: %w", err))
+	} else {
+		for i, gpu := range gpus {
+			reading := models.GPUReading{Index: i, Name: gpu.Name, Driver: gpu.Driver}
+			if gpu.UsagePercent != nil {
+				reading.UsagePercent = *gpu.UsagePercent
+			}
+			snapshot.GPUs = append(snapshot.GPUs, reading)
+		}
+	}
+
+	return snapshot, errors.Join(errs...)
+}
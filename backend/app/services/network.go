@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	stdnet "net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// minNetworkWindow is the shortest window rates are computed over; more frequent requests reuse the last rates
+const minNetworkWindow = time.Second
+
+func init() {
+	registerCollector("network", 2*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetNetworkInterfaces(ctx)
+	})
+}
+
+// GetNetworkInterfaces retrieves flags, addresses, link settings, counters and rates for every interface that passes the network filters.
+// Without a running sampler the first call measures rates over a one second window.
+func (s *SystemService) GetNetworkInterfaces(ctx context.Context) ([]models.NetworkInterface, error) {
+	ctx = s.hostContext(ctx)
+	interfaces, err := net.InterfacesWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network interfaces: %w", err)
+	}
+
+	counters, rates, err := s.networkTraffic(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.NetworkInterface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		if !s.includeInterface(iface.Name) {
+			continue
+		}
+
+		speed, duplex := utils.ReadLinkSettings(s.config.Host.SysRoot, iface.Name)
+		// An interface with no flags set would otherwise be sent as null
+		flags := iface.Flags
+		if flags == nil {
+			flags = []string{}
+		}
+		result = append(result, models.NetworkInterface{
+			Name:         iface.Name,
+			Index:        iface.Index,
+			MTU:          iface.MTU,
+			HardwareAddr: iface.HardwareAddr,
+			Flags:        flags,
+			Addresses:    interfaceAddresses(iface.Addrs),
+			SpeedMbps:    speed,
+			Duplex:       duplex,
+			Counters:     counters[iface.Name],
+			Rates:        rates[iface.Name],
+		})
+	}
+
+	return result, nil
+}
+
+// networkTraffic reads per-interface counters and computes rates over the last sampler interval,
+// or against the previous reading when the sampler is not running
+func (s *SystemService) networkTraffic(ctx context.Context) (map[string]models.NetworkTraffic, map[string]models.NetworkRates, error) {
+	ctx = s.hostContext(ctx)
+	if samples := s.sampler.span(0); samples != nil {
+		first, last := samples[0], samples[len(samples)-1]
+		if first.network != nil && last.network != nil {
+			return last.network, networkRatesBetween(first.network, last.network, last.at.Sub(first.at).Seconds()), nil
+		}
+	}
+
+	s.networkMutex.Lock()
+	defer s.networkMutex.Unlock()
+
+	if s.networkRates != nil && time.Since(s.networkSampledAt) < minNetworkWindow {
+		return s.networkCounters, s.networkRates, nil
+	}
+
+	previous, previousAt := s.networkCounters, s.networkSampledAt
+	if previous == nil {
+		counters, err := readNetworkCounters(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		previous, previousAt = counters, time.Now()
+		if err := sleepContext(ctx, minNetworkWindow); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	current, err := readNetworkCounters(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	rates := networkRatesBetween(previous, current, now.Sub(previousAt).Seconds())
+
+	s.networkCounters = current
+	s.networkRates = rates
+	s.networkSampledAt = now
+
+	return current, rates, nil
+}
+
+// networkRatesBetween computes per-interface rates between two counter readings seconds apart
+func networkRatesBetween(previous, current map[string]models.NetworkTraffic, seconds float64) map[string]models.NetworkRates {
+	rates := make(map[string]models.NetworkRates, len(current))
+	for name, after := range current {
+		// Interfaces that appeared during the window have no baseline yet
+		before, ok := previous[name]
+		if !ok || seconds <= 0 {
+			continue
+		}
+		rate := func(before, after uint64) float64 {
+			return float64(counterDelta(before, after)) / seconds
+		}
+		rates[name] = models.NetworkRates{
+			BytesSent:   rate(before.BytesSent, after.BytesSent),
+			BytesRecv:   rate(before.BytesRecv, after.BytesRecv),
+			PacketsSent: rate(before.PacketsSent, after.PacketsSent),
+			PacketsRecv: rate(before.PacketsRecv, after.PacketsRecv),
+			ErrorsIn:    rate(before.ErrorsIn, after.ErrorsIn),
+			ErrorsOut:   rate(before.ErrorsOut, after.ErrorsOut),
+			DropsIn:     rate(before.DropsIn, after.DropsIn),
+			DropsOut:    rate(before.DropsOut, after.DropsOut),
+		}
+	}
+	return rates
+}
+
+// includeInterface applies the interface include/exclude filters, ignoring case
+func (s *SystemService) includeInterface(name string) bool {
+	filters := s.config.Network
+	name = strings.ToLower(name)
+
+	if len(filters.IncludeInterfaces) > 0 && !matchesAnyFold(filters.IncludeInterfaces, name) {
+		return false
+	}
+	return !matchesAnyFold(filters.ExcludeInterfaces, name)
+}
+
+// readNetworkCounters reads cumulative counters for every interface, keyed by name
+func readNetworkCounters(ctx context.Context) (map[string]models.NetworkTraffic, error) {
+	stats, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network counters: %w", err)
+	}
+
+	counters := make(map[string]models.NetworkTraffic, len(stats))
+	for _, stat := range stats {
+		counters[stat.Name] = models.NetworkTraffic{
+			BytesSent:   stat.BytesSent,
+			BytesRecv:   stat.BytesRecv,
+			PacketsSent: stat.PacketsSent,
+			PacketsRecv: stat.PacketsRecv,
+			ErrorsIn:    stat.Errin,
+			ErrorsOut:   stat.Errout,
+			DropsIn:     stat.Dropin,
+			DropsOut:    stat.Dropout,
+		}
+	}
+	return counters, nil
+}
+
+// interfaceAddresses parses CIDR addresses into address, prefix and family
+func interfaceAddresses(addrs net.InterfaceAddrList) []models.NetworkAddress {
+	result := make([]models.NetworkAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		ip, network, err := stdnet.ParseCIDR(addr.Addr)
+		if err != nil {
+			continue
+		}
+
+		prefix, _ := network.Mask.Size()
+		family := "ipv6"
+		if ip.To4() != nil {
+			family = "ipv4"
+		}
+
+		result = append(result, models.NetworkAddress{
+			Address: ip.String(),
+			Prefix:  prefix,
+			Family:  family,
+		})
+	}
+	return result
+}
+
+// matchesAnyFold reports whether a lowercase value matches any of the glob patterns, ignoring case
+func matchesAnyFold(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), value); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+)
+
+func init() {
+	registerCollector("power", 10*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetPowerStatus(ctx)
+	})
+}
+
+// GetPowerStatus retrieves AC adapter and battery state.
+// Only Linux exposes power supplies; other platforms report no adapter and no batteries.
+func (s *SystemService) GetPowerStatus(ctx context.Context) (*models.PowerStatus, error) {
+	if runtime.GOOS != "linux" {
+		return &models.PowerStatus{Batteries: []models.Battery{}}, nil
+	}
+	return utils.ReadPowerSupplies(s.config.Host.SysRoot)
+}
+
+// OnBattery reports whether the system is running from battery
+func (s *SystemService) OnBattery(ctx context.Context) (bool, error) {
+	status, err := s.GetPowerStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	return status.OnBattery, nil
+}
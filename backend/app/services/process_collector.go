@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+const (
+	// DefaultProcessLimit is the number of processes returned when no limit is given
+	DefaultProcessLimit = 25
+	// MaxProcessLimit is the largest number of processes a single listing may return
+	MaxProcessLimit = 1000
+)
+
+// ErrProcessNotFound is returned when no process exists with the requested PID
+var ErrProcessNotFound = errors.New("process not found")
+
+// ErrInvalidProcessQuery is returned when a process listing has unsupported options
+var ErrInvalidProcessQuery = errors.New("invalid process query")
+
+// trackedProcess keeps a gopsutil handle between listings so CPU usage can be measured as a delta
+type trackedProcess struct {
+	proc       *process.Process
+	createTime int64
+}
+
+// GetProcesses lists processes matching the query
+func (s *SystemService) GetProcesses(ctx context.Context, query models.ProcessQuery) (*models.ProcessList, error) {
+	ctx = s.hostContext(ctx)
+	less, err := processOrdering(query.Sort, query.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultProcessLimit
+	}
+	if limit > MaxProcessLimit {
+		limit = MaxProcessLimit
+	}
+
+	procs, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes: %w", err)
+	}
+
+	name := strings.ToLower(query.Name)
+	user := strings.ToLower(query.User)
+
+	summaries := make([]models.ProcessSummary, 0, len(procs))
+	live := make(map[int32]bool, len(procs))
+	for _, p := range procs {
+		live[p.Pid] = true
+
+		// Processes may exit while they are being listed; skip any we can no longer read
+		summary, err := s.summarizeProcess(ctx, p)
+		if err != nil {
+			continue
+		}
+
+		if name != "" && !strings.Contains(strings.ToLower(summary.Name), name) {
+			continue
+		}
+		if user != "" && strings.ToLower(summary.Username) != user {
+			continue
+		}
+
+		summaries = append(summaries, *summary)
+	}
+
+	s.forgetExitedProcesses(live)
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return less(&summaries[i], &summaries[j])
+	})
+
+	total := len(summaries)
+	if len(summaries) > limit {
+		summaries = summaries[:limit]
+	}
+
+	return &models.ProcessList{
+		Total:     total,
+		Processes: summaries,
+	}, nil
+}
+
+// GetProcessDetail retrieves detailed information about a single process
+func (s *SystemService) GetProcessDetail(ctx context.Context, pid int32) (*models.ProcessDetail, error) {
+	ctx = s.hostContext(ctx)
+	p, err := process.NewProcessWithContext(ctx, pid)
+	if err != nil {
+		if errors.Is(err, process.ErrorProcessNotRunning) {
+			return nil, fmt.Errorf("%w: %d", ErrProcessNotFound, pid)
+		}
+		return nil, fmt.Errorf("failed to open process %d: %w", pid, err)
+	}
+
+	summary, err := s.summarizeProcess(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read process %d: %w", pid, err)
+	}
+
+	// Details other than the summary are best effort; many need elevated privileges
+	detail := &models.ProcessDetail{ProcessSummary: *summary}
+	detail.Exe, _ = p.ExeWithContext(ctx)
+	detail.Cmdline, _ = p.CmdlineWithContext(ctx)
+	detail.Cwd, _ = p.CwdWithContext(ctx)
+	if nice, err := p.NiceWithContext(ctx); err == nil {
+		// On Linux gopsutil returns the raw getpriority value, which is 20 - nice
+		if runtime.GOOS == "linux" {
+			nice = 20 - nice
+		}
+		detail.Nice = nice
+	}
+
+	if fds, err := p.NumFDsWithContext(ctx); err == nil {
+		detail.OpenFiles = int(fds)
+	} else if files, err := p.OpenFilesWithContext(ctx); err == nil {
+		detail.OpenFiles = len(files)
+	}
+
+	if children, err := p.ChildrenWithContext(ctx); err == nil {
+		for _, child := range children {
+			detail.Children = append(detail.Children, child.Pid)
+		}
+	}
+
+	if connections, err := p.ConnectionsWithContext(ctx); err == nil {
+		for _, c := range connections {
+			detail.Connections = append(detail.Connections, models.ProcessConnection{
+				Type:       connectionType(c),
+				Status:     c.Status,
+				LocalAddr:  formatAddr(c.Laddr),
+				RemoteAddr: formatAddr(c.Raddr),
+			})
+		}
+	}
+
+	return detail, nil
+}
+
+// summarizeProcess reads the resource usage of a process
+func (s *SystemService) summarizeProcess(ctx context.Context, p *process.Process) (*models.ProcessSummary, error) {
+	name, err := p.NameWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	createTime, err := p.CreateTimeWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.ProcessSummary{
+		PID:        p.Pid,
+		Name:       name,
+		CPUPercent: s.processCPUPercent(ctx, p, createTime),
+		StartTime:  time.UnixMilli(createTime),
+	}
+
+	summary.PPID, _ = p.PpidWithContext(ctx)
+	summary.Username, _ = p.UsernameWithContext(ctx)
+	if status, err := p.StatusWithContext(ctx); err == nil && len(status) > 0 {
+		summary.Status = status[0]
+	}
+	summary.MemoryPercent, _ = p.MemoryPercentWithContext(ctx)
+	if memory, err := p.MemoryInfoWithContext(ctx); err == nil {
+		summary.MemoryRSS = memory.RSS
+	}
+	if io, err := p.IOCountersWithContext(ctx); err == nil {
+		summary.IOReadBytes = io.ReadBytes
+		summary.IOWriteBytes = io.WriteBytes
+	}
+	summary.NumThreads, _ = p.NumThreadsWithContext(ctx)
+
+	return summary, nil
+}
+
+// processCPUPercent returns CPU usage since the process was last seen, or its lifetime average when first seen
+func (s *SystemService) processCPUPercent(ctx context.Context, p *process.Process, createTime int64) float64 {
+	s.processMutex.Lock()
+	tracked, ok := s.processes[p.Pid]
+	// A different create time means the PID was reused by a new process
+	if !ok || tracked.createTime != createTime {
+		tracked = &trackedProcess{proc: p, createTime: createTime}
+		s.processes[p.Pid] = tracked
+		ok = false
+	}
+	s.processMutex.Unlock()
+
+	if !ok {
+		// Prime the delta for the next listing
+		_, _ = tracked.proc.PercentWithContext(ctx, 0)
+		percent, _ := tracked.proc.CPUPercentWithContext(ctx)
+		return percent
+	}
+
+	percent, _ := tracked.proc.PercentWithContext(ctx, 0)
+	return percent
+}
+
+// forgetExitedProcesses drops tracking state for processes that no longer exist
+func (s *SystemService) forgetExitedProcesses(live map[int32]bool) {
+	s.processMutex.Lock()
+	defer s.processMutex.Unlock()
+
+	for pid := range s.processes {
+		if !live[pid] {
+			delete(s.processes, pid)
+		}
+	}
+}
+
+// processOrdering returns the comparison for a sort key and order
+func processOrdering(key, order string) (func(a, b *models.ProcessSummary) bool, error) {
+	var less func(a, b *models.ProcessSummary) bool
+	switch strings.ToLower(key) {
+	case "", "cpu":
+		less = func(a, b *models.ProcessSummary) bool { return a.CPUPercent < b.CPUPercent }
+	case "memory":
+		less = func(a, b *models.ProcessSummary) bool { return a.MemoryRSS < b.MemoryRSS }
+	case "io":
+		less = func(a, b *models.ProcessSummary) bool {
+			return a.IOReadBytes+a.IOWriteBytes < b.IOReadBytes+b.IOWriteBytes
+		}
+	case "start":
+		less = func(a, b *models.ProcessSummary) bool { return a.StartTime.Before(b.StartTime) }
+	default:
+		return nil, fmt.Errorf("%w: unknown sort %q (use cpu, memory, io or start)", ErrInvalidProcessQuery, key)
+	}
+
+	switch strings.ToLower(order) {
+	case "", "desc":
+		return func(a, b *models.ProcessSummary) bool { return less(b, a) }, nil
+	case "asc":
+		return less, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown order %q (use asc or desc)", ErrInvalidProcessQuery, order)
+	}
+}
+
+// connectionType names the protocol of a connection
+func connectionType(c net.ConnectionStat) string {
+	if c.Family == syscall.AF_UNIX {
+		return "unix"
+	}
+
+	protocol := "udp"
+	if c.Type == syscall.SOCK_STREAM {
+		protocol = "tcp"
+	}
+	if c.Family == syscall.AF_INET6 {
+		protocol += "6"
+	}
+	return protocol
+}
+
+// formatAddr formats a connection endpoint, returning an empty string when unset
+func formatAddr(addr net.Addr) string {
+	if addr.IP == "" && addr.Port == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", addr.IP, addr.Port)
+}
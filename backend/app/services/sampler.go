@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// ErrInvalidWindow is returned when a usage window is negative or longer than the sampler keeps samples
+var ErrInvalidWindow = errors.New("invalid usage window")
+
+// usageSample represents the cumulative counters and current levels read by one sampler tick.
+// Optional readings are nil where the platform does not provide them.
+type usageSample struct {
+	at        time.Time
+	cpu       *cpuReading
+	memory    *mem.VirtualMemoryStat
+	diskTotal uint64
+	diskUsed  uint64
+	diskIO    map[string]disk.IOCountersStat
+	network   map[string]models.NetworkTraffic
+	cgroup    *models.Cgroup
+	gpus      []models.GPU
+}
+
+// usageSampler keeps a ring buffer of recent usage samples taken in the background
+type usageSampler struct {
+	logger    *log.Logger
+	interval  time.Duration
+	retention time.Duration
+
+	mutex   sync.RWMutex
+	samples []usageSample
+	next    int // slot written by the next sample
+	count   int
+	cancel  context.CancelFunc
+}
+
+// newUsageSampler creates a sampler keeping enough samples to cover the configured retention
+func newUsageSampler(cfg config.SamplerConfig) *usageSampler {
+	interval := time.Duration(cfg.Interval) * time.Second
+	retention := time.Duration(cfg.Retention) * time.Second
+	return &usageSampler{
+		logger:    log.New(os.Stdout, "[SAMPLER] ", log.LstdFlags),
+		interval:  interval,
+		retention: retention,
+		samples:   make([]usageSample, int(retention/interval)+1),
+	}
+}
+
+// add stores a sample, overwriting the oldest once the buffer is full
+func (u *usageSampler) add(sample usageSample) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.samples[u.next] = sample
+	u.next = (u.next + 1) % len(u.samples)
+	if u.count < len(u.samples) {
+		u.count++
+	}
+}
+
+// span returns the samples covering the last window in chronological order, starting with the
+// sample taken closest to window before the latest one. A zero window spans the last interval.
+// It returns nil until two samples exist or when the sampler has stopped.
+func (u *usageSampler) span(window time.Duration) []usageSample {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	if u.count < 2 {
+		return nil
+	}
+
+	chronological := make([]usageSample, u.count)
+	for i := range chronological {
+		chronological[i] = u.samples[(u.next-u.count+i+len(u.samples))%len(u.samples)]
+	}
+
+	latest := chronological[len(chronological)-1]
+	// A stopped sampler leaves old samples behind; callers fall back to measuring directly
+	if time.Since(latest.at) > 3*u.interval {
+		return nil
+	}
+
+	start := len(chronological) - 2
+	// Allow half an interval of jitter so a 3s window does not reach back to a sample 4s old
+	for start > 0 && latest.at.Sub(chronological[start].at) < window-u.interval/2 {
+		start--
+	}
+	return chronological[start:]
+}
+
+// validWindow checks a requested usage window against the samples kept
+func (u *usageSampler) validWindow(window time.Duration) error {
+	if window < 0 || window > u.retention {
+		return fmt.Errorf("%w: must be between 0 and %v", ErrInvalidWindow, u.retention)
+	}
+	return nil
+}
+
+// StartSampler samples CPU, GPU, memory, disk, network, and cgroup usage at the configured interval until the
+// context is cancelled or StopSampler is called. Usage queries are answered from these samples
+// instead of measuring for a second on every call.
+func (s *SystemService) StartSampler(ctx context.Context) {
+	s.sampler.mutex.Lock()
+	ctx, s.sampler.cancel = context.WithCancel(ctx)
+	s.sampler.mutex.Unlock()
+
+	s.sampler.logger.Printf("Starting usage sampler with interval: %v, retention: %v", s.sampler.interval, s.sampler.retention)
+
+	ticker := time.NewTicker(s.sampler.interval)
+	defer ticker.Stop()
+
+	for {
+		s.takeSample(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			s.sampler.logger.Println("Usage sampler stopping...")
+			return
+		}
+	}
+}
+
+// StopSampler stops a running sampler
+func (s *SystemService) StopSampler() {
+	s.sampler.mutex.Lock()
+	defer s.sampler.mutex.Unlock()
+
+	if s.sampler.cancel != nil {
+		s.sampler.cancel()
+		s.sampler.cancel = nil
+	}
+}
+
+// takeSample reads the current counters and adds them to the ring buffer.
+// Samples missing CPU, memory, or disk space are dropped, since every usage query needs them.
+func (s *SystemService) takeSample(ctx context.Context) {
+	ctx = s.hostContext(ctx)
+	sample := usageSample{at: time.Now()}
+
+	reading, err := readCPU(ctx, s.config.Host.ProcRoot)
+	if err != nil {
+		s.sampler.logger.Printf("Sampling CPU failed: %v", err)
+		return
+	}
+	sample.cpu = reading
+
+	if sample.memory, err = mem.VirtualMemoryWithContext(ctx); err != nil {
+		s.sampler.logger.Printf("Sampling memory failed: %v", err)
+		return
+	}
+
+	if sample.diskTotal, sample.diskUsed, _, err = s.diskTotals(ctx); err != nil {
+		s.sampler.logger.Printf("Sampling disk space failed: %v", err)
+		return
+	}
+
+	if counters, err := disk.IOCountersWithContext(ctx); err == nil {
+		sample.diskIO = counters
+	}
+	if counters, err := readNetworkCounters(ctx); err == nil {
+		sample.network = counters
+	}
+	if runtime.GOOS == "linux" {
+		if group, err := s.GetCgroup(ctx); err == nil {
+			sample.cgroup = group
+		}
+	}
+	// GPU tools can take seconds to answer, so GPUs are read through their cached collector
+	if gpus, err := s.cachedGPUs(ctx); err == nil {
+		sample.gpus = gpus
+	}
+
+	s.sampler.add(sample)
+}
+
+// cachedGPUs reads the GPUs through the "gpus" collector so slow platform tools run at most once per TTL
+func (s *SystemService) cachedGPUs(ctx context.Context) ([]models.GPU, error) {
+	value, err := s.Collect(ctx, "gpus")
+	if err != nil {
+		return nil, err
+	}
+	return value.([]models.GPU), nil
+}
+
+// latestGPUs returns the GPUs of the latest sample, or reads them through the cache when the sampler is not running
+func (s *SystemService) latestGPUs(ctx context.Context) ([]models.GPU, error) {
+	if samples := s.sampler.span(0); samples != nil {
+		if gpus := samples[len(samples)-1].gpus; gpus != nil {
+			return gpus, nil
+		}
+	}
+	return s.cachedGPUs(ctx)
+}
+
+// gpuUsage returns the usage of the first GPU, which usage queries report, and whether it is known
+func gpuUsage(gpus []models.GPU) (float64, bool) {
+	if len(gpus) == 0 || gpus[0].UsagePercent == nil {
+		return 0, false
+	}
+	return *gpus[0].UsagePercent, true
+}
+
+// usageBetween averages usage over the given samples; rates and CPU usage compare the first and last.
+// GPU usage is averaged over the samples where it is known.
+func usageBetween(samples []usageSample) *models.UsageSample {
+	first, last := samples[0], samples[len(samples)-1]
+
+	var memory, diskPercent, gpu float64
+	gpuSamples := 0
+	for _, sample := range samples {
+		memory += sample.memory.UsedPercent
+		if sample.diskTotal > 0 {
+			diskPercent += float64(sample.diskUsed) / float64(sample.diskTotal) * 100
+		}
+		if usage, ok := gpuUsage(sample.gpus); ok {
+			gpu += usage
+			gpuSamples++
+		}
+	}
+
+	usage := &models.UsageSample{
+		Timestamp: last.at,
+		Window:    last.at.Sub(first.at).Seconds(),
+		CPU:       cpuActivityBetween(first.cpu, last.cpu).usage,
+		Memory:    memory / float64(len(samples)),
+		Disk:      diskPercent / float64(len(samples)),
+	}
+	if gpuSamples > 0 {
+		usage.GPU = gpu / float64(gpuSamples)
+	}
+
+	if first.diskIO != nil && last.diskIO != nil {
+		for _, device := range diskIOBetween(first.diskIO, last.diskIO, first.at, last.at).Devices {
+			usage.DiskRead += device.ReadBytesPerSec
+			usage.DiskWrite += device.WriteBytesPerSec
+		}
+	}
+
+	return usage
+}
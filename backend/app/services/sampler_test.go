@@ -0,0 +1,147 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+func TestUsageSamplerSpan(t *testing.T) {
+	const s = time.Second
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		ages   []time.Duration // age of each sample added, oldest first
+		window time.Duration
+		want   []time.Duration // ages of the samples spanned; nil for no span
+	}{
+		{
+			name: "no samples",
+			want: nil,
+		},
+		{
+			name: "one sample",
+			ages: []time.Duration{0},
+			want: nil,
+		},
+		{
+			name: "zero window spans the last interval",
+			ages: []time.Duration{3 * s, 2 * s, s, 0},
+			want: []time.Duration{s, 0},
+		},
+		{
+			name:   "window reaching back to a sample",
+			ages:   []time.Duration{5 * s, 4 * s, 3 * s, 2 * s, s, 0},
+			window: 3 * s,
+			want:   []time.Duration{3 * s, 2 * s, s, 0},
+		},
+		{
+			name:   "jitter does not reach back an extra interval",
+			ages:   []time.Duration{4 * s, 2600 * time.Millisecond, 1300 * time.Millisecond, 0},
+			window: 3 * s,
+			want:   []time.Duration{2600 * time.Millisecond, 1300 * time.Millisecond, 0},
+		},
+		{
+			name:   "window longer than the samples kept",
+			ages:   []time.Duration{2 * s, s, 0},
+			window: 10 * s,
+			want:   []time.Duration{2 * s, s, 0},
+		},
+		{
+			name:   "full ring keeps the newest samples in order",
+			ages:   []time.Duration{14 * s, 13 * s, 12 * s, 11 * s, 10 * s, 9 * s, 8 * s, 7 * s, 6 * s, 5 * s, 4 * s, 3 * s, 2 * s, s, 0},
+			window: 10 * s,
+			want:   []time.Duration{10 * s, 9 * s, 8 * s, 7 * s, 6 * s, 5 * s, 4 * s, 3 * s, 2 * s, s, 0},
+		},
+		{
+			name: "stopped sampler",
+			ages: []time.Duration{5 * s, 4 * s},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler := newUsageSampler(config.SamplerConfig{Interval: 1, Retention: 10})
+			for _, age := range tt.ages {
+				sampler.add(usageSample{at: now.Add(-age)})
+			}
+
+			var got []time.Duration
+			for _, sample := range sampler.span(tt.window) {
+				got = append(got, now.Sub(sample.at))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("span(%v) = %v, want %v", tt.window, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsageSamplerValidWindow(t *testing.T) {
+	sampler := newUsageSampler(config.SamplerConfig{Interval: 1, Retention: 300})
+
+	tests := []struct {
+		window  time.Duration
+		wantErr bool
+	}{
+		{window: 0},
+		{window: 10 * time.Second},
+		{window: 300 * time.Second},
+		{window: -time.Second, wantErr: true},
+		{window: 301 * time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window.String(), func(t *testing.T) {
+			err := sampler.validWindow(tt.window)
+			if tt.wantErr != (err != nil) || (err != nil && !errors.Is(err, ErrInvalidWindow)) {
+				t.Errorf("validWindow(%v) error = %v, wantErr %v", tt.window, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUsageBetween(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	gpu := func(usage float64) []models.GPU {
+		return []models.GPU{{Name: "GPU", UsagePercent: &usage}}
+	}
+	sample := func(offset time.Duration, user, idle, memory float64, diskUsed uint64, gpus []models.GPU) usageSample {
+		return usageSample{
+			at:        start.Add(offset),
+			cpu:       &cpuReading{total: []cpu.TimesStat{{User: user, Idle: idle}}},
+			memory:    &mem.VirtualMemoryStat{UsedPercent: memory},
+			diskTotal: 100,
+			diskUsed:  diskUsed,
+			gpus:      gpus,
+		}
+	}
+
+	got := usageBetween([]usageSample{
+		sample(0, 10, 90, 40, 25, gpu(20)),
+		// GPU usage unknown in this sample, so it does not count towards the average
+		sample(time.Second, 20, 100, 50, 50, []models.GPU{{Name: "GPU"}}),
+		sample(2*time.Second, 40, 120, 60, 75, gpu(40)),
+	})
+
+	want := &models.UsageSample{
+		Timestamp: start.Add(2 * time.Second),
+		Window:    2,
+		CPU:       50,
+		GPU:       30,
+		Memory:    50,
+		Disk:      50,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("usageBetween() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+	"github.com/kishansakhiya/wails-demo/backend/app/utils"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func init() {
+	registerCollector("sensors", 5*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetSensors(ctx)
+	})
+}
+
+// GetSensors retrieves temperature, fan, and voltage readings.
+// Sensors are often missing in virtual machines, so unreadable sensors are skipped rather than reported as errors.
+func (s *SystemService) GetSensors(ctx context.Context) (*models.Sensors, error) {
+	sensors := &models.Sensors{
+		Temperatures: []models.TemperatureSensor{},
+		Fans:         []models.FanSensor{},
+		Voltages:     []models.VoltageSensor{},
+	}
+
+	// Point gopsutil at the configured sysfs root so it reads the same hwmon tree
+	ctx = s.hostContext(ctx)
+	// Partial results come back with warnings for the sensors that could not be read
+	temperatures, _ := host.SensorsTemperaturesWithContext(ctx)
+	for _, t := range temperatures {
+		sensors.Temperatures = append(sensors.Temperatures, models.TemperatureSensor{
+			Key:      t.SensorKey,
+			Celsius:  t.Temperature,
+			High:     t.High,
+			Critical: t.Critical,
+		})
+	}
+
+	if runtime.GOOS == "linux" {
+		if fans, voltages, err := utils.ReadHwmon(s.config.Host.SysRoot); err == nil {
+			sensors.Fans = fans
+			sensors.Voltages = voltages
+		}
+	}
+
+	return sensors, nil
+}
@@ -3,9 +3,13 @@ package services
 import (
 	"context"
 	"fmt"
-	"strings"
+	"os"
+	"runtime"
+	"sync"
 	"time"
 
+	"github.com/kishansakhiya/wails-demo/backend/app/cache"
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
 	"github.com/kishansakhiya/wails-demo/backend/app/models"
 	"github.com/kishansakhiya/wails-demo/backend/app/utils"
 
@@ -13,182 +17,286 @@ import (
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/mem"
-	"github.com/shirou/gopsutil/v3/net"
 )
 
 // SystemService handles all system information gathering
 type SystemService struct {
+	config       *config.Config
+	processMutex sync.Mutex
+	processes    map[int32]*trackedProcess
+
+	// Disk I/O counters from the previous sample, used to compute rates
+	diskIOMutex     sync.Mutex
+	diskIOCounters  map[string]disk.IOCountersStat
+	diskIOSampledAt time.Time
+	diskIOLast      *models.DiskIO
+
+	// Network counters from the previous sample, used to compute rates
+	networkMutex     sync.Mutex
+	networkCounters  map[string]models.NetworkTraffic
+	networkRates     map[string]models.NetworkRates
+	networkSampledAt time.Time
+
+	// vmstat counters from the previous sample, used to compute paging rates
+	pagingMutex     sync.Mutex
+	pagingCounters  *utils.VMStatCounters
+	pagingSampledAt time.Time
+	pagingLast      *pagingRates
+
+	// Ring buffer of recent usage samples taken in the background
+	sampler *usageSampler
+
+	// Collectors serving the /api/v1 and /api/v2 models, sharing one result cache
+	cache        *cache.Cache
+	collectors   *CollectorRegistry
+	collectorsV2 *CollectorRegistry
 }
 
 // NewSystemService creates a new instance of SystemService
-func NewSystemService() *SystemService {
-	return &SystemService{}
+func NewSystemService(cfg *config.Config) *SystemService {
+	s := &SystemService{
+		config:    cfg,
+		processes: make(map[int32]*trackedProcess),
+		sampler:   newUsageSampler(cfg.Sampler),
+	}
+	if cfg.Cache.Enabled {
+		s.cache = cache.New(cfg.Cache.MaxSize)
+	}
+	s.collectors = newBuiltinRegistry(s, false)
+	s.collectorsV2 = newBuiltinRegistry(s, true)
+	return s
+}
+
+func init() {
+	registerCollector("cpu", 2*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.fetchCPUInfo(ctx)
+	})
+	registerCollector("gpus", 5*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetGPUInfo(ctx)
+	})
+	// Sessions, process count, and the UTC offset change while the system runs, so the OS is cached briefly
+	registerCollector("os", 10*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.fetchOSInfo(ctx)
+	})
+	registerCollector("location", time.Hour, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetLocationInfo(ctx)
+	})
+	registerCollector("memory", 2*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.fetchMemoryInfo(ctx)
+	})
+	registerCollector("disk", 30*time.Second, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.fetchDiskInfo(ctx)
+	})
+	registerCollector("hardware", 10*time.Minute, func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetHardwareInfo(ctx)
+	})
 }
 
-// GetAllSystemInfo retrieves all system information
-func (s *SystemService) GetAllSystemInfo() (*models.FinalResponse, error) {
+// GetAllSystemInfo runs every registered collector concurrently and returns the sections that succeeded.
+// Sections that fail or time out are reported in Errors; an error is only returned when every section failed.
+// With fresh set cached sections are bypassed.
+func (s *SystemService) GetAllSystemInfo(ctx context.Context, fresh bool) (*models.FinalResponse, error) {
+	return s.gatherAll(ctx, s.collectors, fresh)
+}
+
+// gatherAll runs the collectors of registry concurrently
+func (s *SystemService) gatherAll(parent context.Context, registry *CollectorRegistry, fresh bool) (*models.FinalResponse, error) {
 	// Use context with timeout for the entire operation
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(parent, time.Duration(s.config.Collector.AggregateTimeout)*time.Second)
 	defer cancel()
 
-	// Create channels for concurrent data fetching
 	type result struct {
-		data any
-		err  error
-		key  string
+		cached   cache.Result
+		err      error
+		key      string
+		duration time.Duration
 	}
 
-	results := make(chan result, 7) // 7 different data types
+	collectors := registry.All()
+	results := make(chan result, len(collectors))
+	started := time.Now()
 
 	// Fetch all data concurrently
-	go func() {
-		data, err := s.fetchCPUInfo()
-		results <- result{data: data, err: err, key: "cpu"}
-	}()
-
-	go func() {
-		data, err := utils.GetGPUInfo()
-		results <- result{data: data, err: err, key: "gpu"}
-	}()
-
-	go func() {
-		data, err := s.fetchOSInfo()
-		results <- result{data: data, err: err, key: "os"}
-	}()
-
-	go func() {
-		location, err := utils.GetLocationInfo()
-		if err != nil {
-			results <- result{data: nil, err: err, key: "location"}
-		} else {
-			results <- result{data: &location, err: nil, key: "location"}
-		}
-	}()
-
-	go func() {
-		data, err := s.fetchMemoryInfo()
-		results <- result{data: data, err: err, key: "memory"}
-	}()
-
-	go func() {
-		data, err := s.fetchDiskInfo()
-		results <- result{data: data, err: err, key: "disk"}
-	}()
-
-	go func() {
-		data, err := s.fetchHardwareInfo()
-		results <- result{data: data, err: err, key: "hardware"}
-	}()
+	for _, collector := range collectors {
+		go func(collector Collector) {
+			start := time.Now()
+			cached, err := s.runCollector(ctx, collector, fresh)
+			results <- result{cached: cached, err: err, key: collector.Name(), duration: time.Since(start)}
+		}(collector)
+	}
 
 	// Collect results
-	var cpuInfo *models.CPU
-	var gpuInfo []models.GPU
-	var osInfo *models.OS
-	var locationInfo *models.Location
-	var memoryInfo *models.Memory
-	var diskInfo *models.Disk
-	var hardwareInfo []models.HardwareInfo
-
-	for i := 0; i < 7; i++ {
+	response := &models.FinalResponse{
+		Sections: make(map[string]any, len(collectors)),
+		Errors:   make(map[string]models.SectionError),
+	}
+	first := true
+	pending := make(map[string]bool, len(collectors))
+	for _, collector := range collectors {
+		pending[collector.Name()] = true
+	}
+
+	for len(pending) > 0 {
 		select {
 		case res := <-results:
+			delete(pending, res.key)
 			if res.err != nil {
-				return nil, fmt.Errorf("failed to get %s info: %w", res.key, res.err)
+				response.Errors[res.key] = newSectionError(res.err, res.duration)
+				continue
+			}
+			response.Sections[res.key] = res.cached.Value
+
+			// The response is as old as its oldest section and stays fresh as long as its shortest-lived one
+			if age := res.cached.Age(); age > response.Age {
+				response.Age = age
 			}
-			switch res.key {
-			case "cpu":
-				cpuInfo = res.data.(*models.CPU)
-			case "gpu":
-				gpuInfo = res.data.([]models.GPU)
-			case "os":
-				osInfo = res.data.(*models.OS)
-			case "location":
-				locationInfo = res.data.(*models.Location)
-			case "memory":
-				memoryInfo = res.data.(*models.Memory)
-			case "disk":
-				diskInfo = res.data.(*models.Disk)
-			case "hardware":
-				hardwareInfo = res.data.([]models.HardwareInfo)
+			if maxAge := res.cached.MaxAge(); first || maxAge < response.MaxAge {
+				response.MaxAge = maxAge
+				first = false
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("timeout while gathering system information")
+			// Report the collectors that did not finish in time
+			for key := range pending {
+				response.Errors[key] = newSectionError(ctx.Err(), time.Since(started))
+			}
+			pending = nil
 		}
 	}
 
-	return &models.FinalResponse{
-		CPU:      *cpuInfo,
-		GPUs:     gpuInfo,
-		OS:       *osInfo,
-		Location: *locationInfo,
-		Memory:   *memoryInfo,
-		Disk:     *diskInfo,
-		Hardware: hardwareInfo,
-	}, nil
+	switch {
+	case len(response.Errors) == 0:
+		response.Status = models.SystemStatusComplete
+	case len(response.Sections) > 0:
+		response.Status = models.SystemStatusPartial
+	default:
+		response.Status = models.SystemStatusFailed
+		return response, fmt.Errorf("failed to gather any system information")
+	}
+
+	return response, nil
 }
 
 // GetCPUInfo retrieves CPU information
-func (s *SystemService) GetCPUInfo() (*models.CPU, error) {
-	return s.fetchCPUInfo()
+func (s *SystemService) GetCPUInfo(ctx context.Context) (*models.CPU, error) {
+	return s.fetchCPUInfo(ctx)
 }
 
-// fetchCPUInfo performs the actual CPU information fetching
-func (s *SystemService) fetchCPUInfo() (*models.CPU, error) {
-	cpuInfo, err := cpu.Info()
+// fetchCPUInfo formats CPU statistics for the v1 API
+func (s *SystemService) fetchCPUInfo(ctx context.Context) (*models.CPU, error) {
+	stats, err := s.GetCPUStats(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get CPU info: %w", err)
-	}
-	cpuPercent, _ := cpu.Percent(time.Second, false)
-	if len(cpuInfo) == 0 {
-		return nil, fmt.Errorf("no CPU information available")
+		return nil, err
 	}
 
-	cpu := cpuInfo[0]
-
-	// Get CPU usage - simplified approach for now
-	usage := cpuPercent[0]
-
 	return &models.CPU{
-		Cores:     cpuInfo[0].Cores,
-		Model:     cpu.ModelName,
-		CacheSize: fmt.Sprintf("%dMB", cpu.CacheSize/1024),
-		Ghz:       fmt.Sprintf("%.2fGHz", float64(cpu.Mhz)/1000),
-		CPUUsage:  fmt.Sprintf("%.2f%%", usage),
+		Cores:                 int32(stats.PhysicalCores),
+		Model:                 stats.Model,
+		CacheSize:             fmt.Sprintf("%dMB", stats.CacheBytes/1024/1024),
+		Ghz:                   fmt.Sprintf("%.2fGHz", stats.FrequencyMHz/1000),
+		CPUUsage:              fmt.Sprintf("%.2f%%", stats.UsagePercent),
+		PhysicalCores:         stats.PhysicalCores,
+		LogicalCores:          stats.LogicalCores,
+		Sockets:               stats.Sockets,
+		PerCoreUsage:          stats.PerCoreUsagePercent,
+		Times:                 stats.Times,
+		LoadAverage:           stats.LoadAverage,
+		ContextSwitchesPerSec: stats.ContextSwitchesPerSec,
+		InterruptsPerSec:      stats.InterruptsPerSec,
 	}, nil
 }
 
 // GetGPUInfo retrieves GPU information
-func (s *SystemService) GetGPUInfo() ([]models.GPU, error) {
-	return utils.GetGPUInfo()
+func (s *SystemService) GetGPUInfo(ctx context.Context) ([]models.GPU, error) {
+	return utils.GetGPUInfo(ctx, s.config.Host.SysRoot)
+}
+
+// Hostname returns the name of the monitored system. The local hostname comes from our own UTS
+// namespace; a mounted host /etc names the host instead. It is empty when neither is available.
+func (s *SystemService) Hostname() string {
+	if s.config.Host.EtcRoot != utils.DefaultEtcRoot {
+		if name := utils.ReadHostname(s.config.Host.EtcRoot); name != "" {
+			return name
+		}
+	}
+	hostname, _ := os.Hostname()
+	return hostname
 }
 
 // GetOSInfo retrieves operating system information
-func (s *SystemService) GetOSInfo() (*models.OS, error) {
-	return s.fetchOSInfo()
+func (s *SystemService) GetOSInfo(ctx context.Context) (*models.OS, error) {
+	return s.fetchOSInfo(ctx)
 }
 
 // fetchOSInfo performs the actual OS information fetching
-func (s *SystemService) fetchOSInfo() (*models.OS, error) {
-	hostInfo, err := host.Info()
+func (s *SystemService) fetchOSInfo(ctx context.Context) (*models.OS, error) {
+	ctx = s.hostContext(ctx)
+	hostInfo, err := host.InfoWithContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get host info: %w", err)
 	}
 
+	hostname := s.Hostname()
+	if hostname == "" {
+		hostname = hostInfo.Hostname
+	}
+
+	initSystem := ""
+	switch runtime.GOOS {
+	case "linux":
+		initSystem = utils.DetectInitSystem(s.config.Host.ProcRoot, s.config.Host.RunRoot)
+	case "darwin":
+		initSystem = "launchd"
+	}
+
+	timezone, offset := utils.ReadTimezone(s.config.Host.EtcRoot)
+	containerRuntime := utils.DetectContainer()
 	return &models.OS{
-		OS:              hostInfo.OS,
-		Hostname:        hostInfo.Hostname,
-		Platform:        hostInfo.Platform,
-		PlatformVersion: hostInfo.PlatformVersion,
-		PlatformFamily:  hostInfo.PlatformFamily,
-		KernelVersion:   hostInfo.KernelVersion,
-		KernelArch:      hostInfo.KernelArch,
-		Uptime:          hostInfo.Uptime,
+		OS:                   hostInfo.OS,
+		Hostname:             hostname,
+		Platform:             hostInfo.Platform,
+		PlatformVersion:      hostInfo.PlatformVersion,
+		PlatformFamily:       hostInfo.PlatformFamily,
+		KernelVersion:        hostInfo.KernelVersion,
+		KernelArch:           hostInfo.KernelArch,
+		Uptime:               hostInfo.Uptime,
+		BootTime:             time.Unix(int64(hostInfo.BootTime), 0),
+		HostID:               hostInfo.HostID,
+		ProcessCount:         hostInfo.Procs,
+		VirtualizationSystem: hostInfo.VirtualizationSystem,
+		VirtualizationRole:   hostInfo.VirtualizationRole,
+		InitSystem:           initSystem,
+		Timezone:             timezone,
+		UTCOffsetSeconds:     offset,
+		Locale:               utils.ReadLocale(s.config.Host.EtcRoot),
+		Users:                s.sessionUsers(ctx),
+		Containerized:        containerRuntime != "",
+		ContainerRuntime:     containerRuntime,
+		HostRoots:            s.hostRoots(),
 	}, nil
 }
 
+// sessionUsers lists logged-in users from utmp. Windows does not expose sessions through gopsutil,
+// and a missing utmp means nobody is logged in, so both yield an empty list.
+func (s *SystemService) sessionUsers(ctx context.Context) []models.SessionUser {
+	users := []models.SessionUser{}
+	stats, err := host.UsersWithContext(ctx)
+	if err != nil {
+		return users
+	}
+	for _, stat := range stats {
+		users = append(users, models.SessionUser{
+			User:     stat.User,
+			Terminal: stat.Terminal,
+			Host:     stat.Host,
+			Started:  time.Unix(int64(stat.Started), 0),
+		})
+	}
+	return users
+}
+
 // GetLocationInfo retrieves location information
-func (s *SystemService) GetLocationInfo() (*models.Location, error) {
-	location, err := utils.GetLocationInfo()
+func (s *SystemService) GetLocationInfo(ctx context.Context) (*models.Location, error) {
+	location, err := utils.GetLocationInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -196,163 +304,258 @@ func (s *SystemService) GetLocationInfo() (*models.Location, error) {
 }
 
 // GetMemoryInfo retrieves memory information
-func (s *SystemService) GetMemoryInfo() (*models.Memory, error) {
-	return s.fetchMemoryInfo()
+func (s *SystemService) GetMemoryInfo(ctx context.Context) (*models.Memory, error) {
+	return s.fetchMemoryInfo(ctx)
 }
 
-// fetchMemoryInfo performs the actual memory information fetching
-func (s *SystemService) fetchMemoryInfo() (*models.Memory, error) {
-	memory, err := mem.VirtualMemory()
+// fetchMemoryInfo formats memory statistics for the v1 API
+func (s *SystemService) fetchMemoryInfo(ctx context.Context) (*models.Memory, error) {
+	stats, err := s.GetMemoryStats(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get memory info: %w", err)
+		return nil, err
 	}
 
 	return &models.Memory{
-		Total:       utils.FormatBytes(memory.Total, 1000),
-		Used:        utils.FormatBytes(memory.Used, 1000),
-		Free:        utils.FormatBytes(memory.Free, 1000),
-		Available:   utils.FormatBytes(memory.Available, 1000),
-		UsedPercent: fmt.Sprintf("%.1f%%", memory.UsedPercent),
+		Total:           utils.FormatBytes(stats.TotalBytes, 1000),
+		Used:            utils.FormatBytes(stats.UsedBytes, 1000),
+		Free:            utils.FormatBytes(stats.FreeBytes, 1000),
+		Available:       utils.FormatBytes(stats.AvailableBytes, 1000),
+		UsedPercent:     fmt.Sprintf("%.1f%%", stats.UsedPercent),
+		Buffers:         utils.FormatBytes(stats.BuffersBytes, 1000),
+		Cached:          utils.FormatBytes(stats.CachedBytes, 1000),
+		Shared:          utils.FormatBytes(stats.SharedBytes, 1000),
+		Slab:            utils.FormatBytes(stats.SlabBytes, 1000),
+		SwapTotal:       utils.FormatBytes(stats.SwapTotalBytes, 1000),
+		SwapUsed:        utils.FormatBytes(stats.SwapUsedBytes, 1000),
+		SwapUsedPercent: fmt.Sprintf("%.1f%%", stats.SwapUsedPercent),
+		SwapIn:          utils.FormatBytes(uint64(stats.SwapInBytesPerSec), 1000) + "/s",
+		SwapOut:         utils.FormatBytes(uint64(stats.SwapOutBytesPerSec), 1000) + "/s",
+		MajorFaults:     fmt.Sprintf("%.1f/s", stats.MajorFaultsPerSec),
+		Pressure:        formatPressure(stats.Pressure),
 	}, nil
 }
 
+// formatPressure formats pressure stall information for the v1 API
+func formatPressure(pressure *models.SystemPressure) *models.PressureStall {
+	if pressure == nil {
+		return nil
+	}
+	return &models.PressureStall{
+		CPU:    formatResourcePressure(pressure.CPU),
+		Memory: formatResourcePressure(pressure.Memory),
+		IO:     formatResourcePressure(pressure.IO),
+	}
+}
+
+// formatResourcePressure formats the stall lines of one PSI file
+func formatResourcePressure(pressure *models.ResourcePressure) *models.ResourceStall {
+	if pressure == nil {
+		return nil
+	}
+	stall := &models.ResourceStall{Some: formatStallAverages(pressure.Some)}
+	if pressure.Full != nil {
+		full := formatStallAverages(*pressure.Full)
+		stall.Full = &full
+	}
+	return stall
+}
+
+// formatStallAverages formats stall percentages and the cumulative stall time of one PSI line
+func formatStallAverages(averages models.PressureAverages) models.StallAverages {
+	return models.StallAverages{
+		Avg10:  fmt.Sprintf("%.2f%%", averages.Avg10),
+		Avg60:  fmt.Sprintf("%.2f%%", averages.Avg60),
+		Avg300: fmt.Sprintf("%.2f%%", averages.Avg300),
+		Total:  (time.Duration(averages.TotalMicros) * time.Microsecond).String(),
+	}
+}
+
 // GetDiskInfo retrieves disk information
-func (s *SystemService) GetDiskInfo() (*models.Disk, error) {
-	return s.fetchDiskInfo()
+func (s *SystemService) GetDiskInfo(ctx context.Context) (*models.Disk, error) {
+	return s.fetchDiskInfo(ctx)
 }
 
-// fetchDiskInfo performs the actual disk information fetching
-func (s *SystemService) fetchDiskInfo() (*models.Disk, error) {
-	partitions, err := disk.Partitions(false)
+// fetchDiskInfo formats disk statistics for the v1 API
+func (s *SystemService) fetchDiskInfo(ctx context.Context) (*models.Disk, error) {
+	stats, err := s.GetDiskStats(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get disk partitions: %w", err)
+		return nil, err
 	}
 
-	var totalSize, totalUsed, totalFree uint64
+	return &models.Disk{
+		Total:       utils.FormatBytes(stats.TotalBytes, 1000),
+		Used:        utils.FormatBytes(stats.UsedBytes, 1000),
+		Free:        utils.FormatBytes(stats.FreeBytes, 1000),
+		UsedPercent: fmt.Sprintf("%.1f%%", stats.UsedPercent),
+	}, nil
+}
 
-	for _, partition := range partitions {
-		// Skip certain filesystem types that might cause issues
-		if strings.Contains(strings.ToLower(partition.Fstype), "tmpfs") ||
-			strings.Contains(strings.ToLower(partition.Fstype), "devtmpfs") {
-			continue
-		}
+// diskTotals sums total, used and free space across partitions, counting each device once
+func (s *SystemService) diskTotals(ctx context.Context) (uint64, uint64, uint64, error) {
+	partitions, err := s.diskPartitions(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
 
-		usage, err := disk.Usage(partition.Mountpoint)
-		if err != nil {
-			continue
-		}
+	var totalSize, totalUsed, totalFree uint64
+	for _, partition := range uniqueDevices(partitions) {
+		totalSize += partition.Total
+		totalUsed += partition.Used
+		totalFree += partition.Free
+	}
 
-		totalSize += usage.Total
-		totalUsed += usage.Used
-		totalFree += usage.Free
+	return totalSize, totalUsed, totalFree, nil
+}
+
+// diskUsages returns space usage for every mounted partition
+func (s *SystemService) diskUsages(ctx context.Context) ([]models.DiskUsage, error) {
+	partitions, err := s.diskPartitions(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	usedPercent := float64(0)
-	if totalSize > 0 {
-		usedPercent = float64(totalUsed) / float64(totalSize) * 100
+	usages := make([]models.DiskUsage, 0, len(partitions))
+	for _, partition := range partitions {
+		usages = append(usages, models.DiskUsage{
+			Device:     partition.Device,
+			Mountpoint: partition.Mountpoint,
+			Fstype:     partition.Fstype,
+			Total:      partition.Total,
+			Used:       partition.Used,
+			Free:       partition.Free,
+		})
 	}
 
-	return &models.Disk{
-		Total:       utils.FormatBytes(totalSize, 1000),
-		Used:        utils.FormatBytes(totalUsed, 1000),
-		Free:        utils.FormatBytes(totalFree, 1000),
-		UsedPercent: fmt.Sprintf("%.1f%%", usedPercent),
-	}, nil
+	return usages, nil
 }
 
-// GetHardwareInfo retrieves hardware/network interface information
-func (s *SystemService) GetHardwareInfo() ([]models.HardwareInfo, error) {
-	return s.fetchHardwareInfo()
+// GetHardwareInfo retrieves the DMI/SMBIOS hardware inventory.
+// Only Linux exposes DMI through sysfs; other platforms report empty fields.
+func (s *SystemService) GetHardwareInfo(ctx context.Context) (*models.HardwareInfo, error) {
+	if runtime.GOOS != "linux" {
+		return &models.HardwareInfo{}, nil
+	}
+	return utils.ReadDMI(s.config.Host.SysRoot), nil
 }
 
-// fetchHardwareInfo performs the actual hardware information fetching
-func (s *SystemService) fetchHardwareInfo() ([]models.HardwareInfo, error) {
-	interfaces, err := net.Interfaces()
+// GetUsagePercentages retrieves usage percentages for CPU, GPU, memory, and disk averaged over window.
+// A zero window returns the latest usage. With base set to cgroup, CPU and memory are relative to the
+// process's cgroup limits instead of the host; an empty base means host.
+func (s *SystemService) GetUsagePercentages(ctx context.Context, window time.Duration, base string) (*models.UsagePercentages, error) {
+	if base == "" {
+		base = UsageBaseHost
+	}
+	if base != UsageBaseHost && base != UsageBaseCgroup {
+		return nil, fmt.Errorf("%w: must be %s or %s", ErrInvalidUsageBase, UsageBaseHost, UsageBaseCgroup)
+	}
+
+	usage, err := s.sampleUsage(ctx, window)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get network interfaces: %w", err)
-	}
-
-	var hardwareInfo []models.HardwareInfo
-	for _, iface := range interfaces {
-		// Skip loopback interfaces and interfaces without hardware address
-		if strings.Contains(strings.ToLower(iface.Name), "lo") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "br-") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "docker") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "veth") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "tun") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "tap") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "wg") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "vnet") ||
-			strings.HasPrefix(strings.ToLower(iface.Name), "veth") ||
-			iface.HardwareAddr == "" {
-			continue
-		}
+		return nil, err
+	}
 
-		flags := []string{}
-		// Simplified flag checking since gopsutil v3 has different flag constants
-		if strings.Contains(strings.ToLower(iface.Name), "up") {
-			flags = append(flags, "up")
+	if base == UsageBaseCgroup {
+		cpuPercent, memoryPercent, err := s.cgroupUsage(ctx, window)
+		if err != nil {
+			return nil, err
 		}
-		if strings.Contains(strings.ToLower(iface.Name), "broadcast") {
-			flags = append(flags, "broadcast")
+		// Usage without a matching cgroup controller stays relative to the host
+		if cpuPercent != nil {
+			usage.CPU = *cpuPercent
 		}
-		if strings.Contains(strings.ToLower(iface.Name), "multicast") {
-			flags = append(flags, "multicast")
+		if memoryPercent != nil {
+			usage.Memory = *memoryPercent
 		}
-
-		hardwareInfo = append(hardwareInfo, models.HardwareInfo{
-			Index:        iface.Index,
-			MTU:          iface.MTU,
-			Name:         iface.Name,
-			HardwareAddr: iface.HardwareAddr,
-			Flags:        strings.Join(flags, "|"),
-		})
 	}
 
-	return hardwareInfo, nil
+	return &models.UsagePercentages{
+		CPU:        fmt.Sprintf("%.2f%%", usage.CPU),
+		GPU:        fmt.Sprintf("%.0f%%", usage.GPU),
+		Memory:     fmt.Sprintf("%.1f%%", usage.Memory),
+		Disk:       fmt.Sprintf("%.1f%%", usage.Disk),
+		RelativeTo: base,
+	}, nil
 }
 
-// GetUsagePercentages retrieves usage percentages for CPU, GPU, memory, and disk
-func (s *SystemService) GetUsagePercentages() (*models.UsagePercentages, error) {
-	return s.fetchUsagePercentages()
+// SampleUsage takes a numeric usage measurement for CPU, GPU, memory, and disk
+func (s *SystemService) SampleUsage(ctx context.Context) (*models.UsageSample, error) {
+	return s.SampleUsageWindow(ctx, 0)
 }
 
-// fetchUsagePercentages performs the actual usage percentages fetching
-func (s *SystemService) fetchUsagePercentages() (*models.UsagePercentages, error) {
-	// Get CPU usage
-	cpuInfo, err := s.GetCPUInfo()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get CPU usage: %w", err)
+// SampleUsageWindow averages numeric usage for CPU, GPU, memory, and disk over window.
+// A zero window covers the last sampler interval.
+func (s *SystemService) SampleUsageWindow(ctx context.Context, window time.Duration) (*models.UsageSample, error) {
+	return s.sampleUsage(ctx, window)
+}
+
+// sampleUsage answers from the background samples, or measures for a second when the sampler is not running
+func (s *SystemService) sampleUsage(ctx context.Context, window time.Duration) (*models.UsageSample, error) {
+	if err := s.sampler.validWindow(window); err != nil {
+		return nil, err
+	}
+	if samples := s.sampler.span(window); samples != nil {
+		return usageBetween(samples), nil
 	}
+	return s.measureUsage(ctx)
+}
 
-	// Get GPU usage
-	gpuInfo, err := s.GetGPUInfo()
+// measureUsage measures CPU usage over one second and reads GPU, memory, disk, and disk I/O usage
+func (s *SystemService) measureUsage(ctx context.Context) (*models.UsageSample, error) {
+	ctx = s.hostContext(ctx)
+	cpuPercent, err := cpu.PercentWithContext(ctx, time.Second, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get GPU usage: %w", err)
+		return nil, fmt.Errorf("failed to get CPU usage: %w", err)
+	}
+	if len(cpuPercent) == 0 {
+		return nil, fmt.Errorf("no CPU usage available")
 	}
 
-	// Get memory usage
-	memoryInfo, err := s.GetMemoryInfo()
+	memory, err := mem.VirtualMemoryWithContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get memory usage: %w", err)
 	}
 
-	// Get disk usage
-	diskInfo, err := s.GetDiskInfo()
+	totalSize, totalUsed, _, err := s.diskTotals(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get disk usage: %w", err)
 	}
 
-	// Get GPU usage percentage (use the first GPU if available)
-	gpuUsage := "0%"
-	if len(gpuInfo) > 0 {
-		gpuUsage = gpuInfo[0].Usage
+	diskPercent := float64(0)
+	if totalSize > 0 {
+		diskPercent = float64(totalUsed) / float64(totalSize) * 100
 	}
 
-	return &models.UsagePercentages{
-		CPU:    cpuInfo.CPUUsage,
-		GPU:    gpuUsage,
-		Memory: memoryInfo.UsedPercent,
-		Disk:   diskInfo.UsedPercent,
-	}, nil
+	diskRead, diskWrite, err := s.diskIOTotals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk I/O: %w", err)
+	}
+
+	usage := &models.UsageSample{
+		Timestamp: time.Now(),
+		Window:    time.Second.Seconds(),
+		CPU:       cpuPercent[0],
+		Memory:    memory.UsedPercent,
+		Disk:      diskPercent,
+		DiskRead:  diskRead,
+		DiskWrite: diskWrite,
+	}
+	// GPU usage stays 0 when no GPU reports it
+	if gpus, err := s.cachedGPUs(ctx); err == nil {
+		usage.GPU, _ = gpuUsage(gpus)
+	}
+
+	return usage, nil
+}
+
+// sleepContext waits for d, returning early with the context's error when ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/config"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestGatherAllStatus(t *testing.T) {
+	ok := func(ctx context.Context) (any, error) { return "value", nil }
+	failing := func(ctx context.Context) (any, error) { return nil, errors.New("collector failed") }
+	hanging := func(ctx context.Context) (any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	tests := []struct {
+		name         string
+		collectors   map[string]func(ctx context.Context) (any, error)
+		wantStatus   string
+		wantSections []string
+		wantErrors   map[string]string // section -> error code
+		wantErr      bool
+	}{
+		{
+			name:         "every section succeeded",
+			collectors:   map[string]func(ctx context.Context) (any, error){"cpu": ok, "memory": ok},
+			wantStatus:   models.SystemStatusComplete,
+			wantSections: []string{"cpu", "memory"},
+			wantErrors:   map[string]string{},
+		},
+		{
+			name:         "one section failed",
+			collectors:   map[string]func(ctx context.Context) (any, error){"cpu": ok, "location": failing},
+			wantStatus:   models.SystemStatusPartial,
+			wantSections: []string{"cpu"},
+			wantErrors:   map[string]string{"location": SectionErrorCollector},
+		},
+		{
+			name:         "one section timed out",
+			collectors:   map[string]func(ctx context.Context) (any, error){"cpu": ok, "gpus": hanging},
+			wantStatus:   models.SystemStatusPartial,
+			wantSections: []string{"cpu"},
+			wantErrors:   map[string]string{"gpus": SectionErrorTimeout},
+		},
+		{
+			name:         "every section failed",
+			collectors:   map[string]func(ctx context.Context) (any, error){"gpus": hanging, "location": failing},
+			wantStatus:   models.SystemStatusFailed,
+			wantSections: []string{},
+			wantErrors:   map[string]string{"gpus": SectionErrorTimeout, "location": SectionErrorCollector},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.Collector.AggregateTimeout = 1
+			s := NewSystemService(cfg)
+
+			registry := NewCollectorRegistry()
+			for name, collect := range tt.collectors {
+				if err := registry.Register(NewCollector(name, time.Minute, collect)); err != nil {
+					t.Fatalf("Register(%q) error = %v", name, err)
+				}
+			}
+
+			response, err := s.gatherAll(context.Background(), registry, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gatherAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if response.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", response.Status, tt.wantStatus)
+			}
+
+			sections := []string{}
+			for name := range response.Sections {
+				sections = append(sections, name)
+			}
+			sort.Strings(sections)
+			if !reflect.DeepEqual(sections, tt.wantSections) {
+				t.Errorf("Sections = %v, want %v", sections, tt.wantSections)
+			}
+
+			codes := map[string]string{}
+			for name, sectionErr := range response.Errors {
+				codes[name] = sectionErr.Code
+			}
+			if !reflect.DeepEqual(codes, tt.wantErrors) {
+				t.Errorf("Errors = %v, want %v", codes, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/cache"
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+func init() {
+	registerCollectorV2("cpu", func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetCPUStats(ctx)
+	})
+	registerCollectorV2("gpus", func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetGPUStats(ctx)
+	})
+	registerCollectorV2("memory", func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetMemoryStats(ctx)
+	})
+	registerCollectorV2("disk", func(s *SystemService, ctx context.Context) (any, error) {
+		return s.GetDiskStats(ctx)
+	})
+}
+
+// GetCPUStats retrieves CPU information with numeric values
+func (s *SystemService) GetCPUStats(ctx context.Context) (*models.CPUV2, error) {
+	ctx = s.hostContext(ctx)
+	cpuInfo, err := cpu.InfoWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CPU info: %w", err)
+	}
+	if len(cpuInfo) == 0 {
+		return nil, fmt.Errorf("no CPU information available")
+	}
+
+	physicalCores, err := cpu.CountsWithContext(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get physical core count: %w", err)
+	}
+	logicalCores, err := cpu.CountsWithContext(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logical core count: %w", err)
+	}
+
+	activity, err := s.currentCPUActivity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CPU usage: %w", err)
+	}
+
+	first := cpuInfo[0]
+
+	return &models.CPUV2{
+		Model:         first.ModelName,
+		PhysicalCores: physicalCores,
+		LogicalCores:  logicalCores,
+		Sockets:       countSockets(cpuInfo),
+		// gopsutil reports the cache size in KB
+		CacheBytes:            uint64(first.CacheSize) * 1024,
+		FrequencyMHz:          first.Mhz,
+		UsagePercent:          activity.usage,
+		PerCoreUsagePercent:   activity.perCoreUsage,
+		Times:                 activity.times,
+		LoadAverage:           loadAverage(ctx),
+		ContextSwitchesPerSec: activity.ctxSwitches,
+		InterruptsPerSec:      activity.interrupts,
+	}, nil
+}
+
+// GetGPUStats retrieves GPU information with numeric values; unknown values are 0 or null
+func (s *SystemService) GetGPUStats(ctx context.Context) ([]models.GPUV2, error) {
+	gpus, err := s.GetGPUInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]models.GPUV2, 0, len(gpus))
+	for _, gpu := range gpus {
+		stats = append(stats, models.GPUV2{
+			Name:         gpu.Name,
+			Driver:       gpu.Driver,
+			PCIAddress:   gpu.PCIAddress,
+			VRAMBytes:    gpu.VRAMBytes,
+			UsagePercent: gpu.UsagePercent,
+			ClockMHz:     gpu.ClockMHz,
+		})
+	}
+
+	return stats, nil
+}
+
+// GetMemoryStats retrieves memory information with numeric values
+func (s *SystemService) GetMemoryStats(ctx context.Context) (*models.MemoryV2, error) {
+	ctx = s.hostContext(ctx)
+	memory, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memory info: %w", err)
+	}
+
+	swap, err := mem.SwapMemoryWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swap info: %w", err)
+	}
+
+	stats := &models.MemoryV2{
+		TotalBytes:      memory.Total,
+		UsedBytes:       memory.Used,
+		FreeBytes:       memory.Free,
+		AvailableBytes:  memory.Available,
+		UsedPercent:     memory.UsedPercent,
+		BuffersBytes:    memory.Buffers,
+		CachedBytes:     memory.Cached,
+		SharedBytes:     memory.Shared,
+		SlabBytes:       memory.Slab,
+		SwapTotalBytes:  swap.Total,
+		SwapUsedBytes:   swap.Used,
+		SwapUsedPercent: swap.UsedPercent,
+		Pressure:        readSystemPressure(s.config.Host.ProcRoot),
+	}
+
+	// Paging rates come from /proc/vmstat and are only available on Linux
+	if rates, err := s.measurePaging(ctx); err == nil {
+		stats.SwapInBytesPerSec = rates.swapIn
+		stats.SwapOutBytesPerSec = rates.swapOut
+		stats.MajorFaultsPerSec = rates.majorFaults
+	}
+
+	return stats, nil
+}
+
+// GetDiskStats retrieves total disk space with numeric values
+func (s *SystemService) GetDiskStats(ctx context.Context) (*models.DiskV2, error) {
+	totalSize, totalUsed, totalFree, err := s.diskTotals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usedPercent := float64(0)
+	if totalSize > 0 {
+		usedPercent = float64(totalUsed) / float64(totalSize) * 100
+	}
+
+	return &models.DiskV2{
+		TotalBytes:  totalSize,
+		UsedBytes:   totalUsed,
+		FreeBytes:   totalFree,
+		UsedPercent: usedPercent,
+	}, nil
+}
+
+// GetUsageStats retrieves usage with numeric values averaged over window; a zero window returns the latest usage
+func (s *SystemService) GetUsageStats(ctx context.Context, window time.Duration) (*models.UsageV2, error) {
+	sample, err := s.SampleUsageWindow(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UsageV2{
+		WindowSeconds:        sample.Window,
+		CPUPercent:           sample.CPU,
+		GPUPercent:           sample.GPU,
+		MemoryPercent:        sample.Memory,
+		DiskPercent:          sample.Disk,
+		DiskReadBytesPerSec:  sample.DiskRead,
+		DiskWriteBytesPerSec: sample.DiskWrite,
+	}, nil
+}
+
+// GetAllSystemInfoV2 runs every registered collector with its v2 model and returns the sections that succeeded
+func (s *SystemService) GetAllSystemInfoV2(ctx context.Context, fresh bool) (*models.FinalResponse, error) {
+	return s.gatherAll(ctx, s.collectorsV2, fresh)
+}
+
+// CollectResultV2 runs the collector registered under name with its v2 model and reports how fresh its result is
+func (s *SystemService) CollectResultV2(ctx context.Context, name string, fresh bool) (cache.Result, error) {
+	collector, err := s.collectorsV2.Get(name)
+	if err != nil {
+		return cache.Result{}, err
+	}
+	return s.runCollector(ctx, collector, fresh)
+}
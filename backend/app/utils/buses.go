@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// ReadPCIDevices enumerates the devices in <sysRoot>/bus/pci/devices (Linux only), in address order.
+// Names are best-effort lookups in the bundled pci.ids subset and are empty for unlisted hardware.
+// Systems without a PCI bus report no devices.
+func ReadPCIDevices(sysRoot string) ([]models.PCIDevice, error) {
+	dir := filepath.Join(sysRoot, "bus", "pci", "devices")
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []models.PCIDevice{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PCI devices: %w", err)
+	}
+
+	devices := make([]models.PCIDevice, 0, len(entries))
+	for _, entry := range entries {
+		device := filepath.Join(dir, entry.Name())
+		read := func(name string) string {
+			return normalizeID(readSysfsString(filepath.Join(device, name)))
+		}
+
+		pci := models.PCIDevice{
+			Address:           entry.Name(),
+			VendorID:          read("vendor"),
+			DeviceID:          read("device"),
+			SubsystemVendorID: read("subsystem_vendor"),
+			SubsystemDeviceID: read("subsystem_device"),
+			Class:             read("class"),
+			Revision:          read("revision"),
+			Driver:            sysfsDriver(device),
+		}
+		pci.Vendor, pci.Device = LookupPCI(pci.VendorID, pci.DeviceID)
+		// class holds the class, subclass, and programming interface, e.g. 0x030000
+		if len(pci.Class) == 6 {
+			pci.ClassName = LookupPCIClass(pci.Class[:2], pci.Class[2:4])
+		}
+
+		devices = append(devices, pci)
+	}
+
+	return devices, nil
+}
+
+// ReadUSBDevices enumerates the devices in <sysRoot>/bus/usb/devices (Linux only), root hubs included.
+// Names come from the bundled usb.ids subset, falling back to the strings the device reports.
+// Systems without a USB controller report no devices.
+func ReadUSBDevices(sysRoot string) ([]models.USBDevice, error) {
+	dir := filepath.Join(sysRoot, "bus", "usb", "devices")
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []models.USBDevice{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list USB devices: %w", err)
+	}
+
+	devices := []models.USBDevice{}
+	for _, entry := range entries {
+		// Interfaces are listed next to devices as <port>:<config>.<interface>
+		if strings.Contains(entry.Name(), ":") {
+			continue
+		}
+		device := filepath.Join(dir, entry.Name())
+		read := func(name string) string {
+			return readSysfsString(filepath.Join(device, name))
+		}
+
+		usb := models.USBDevice{
+			Port:       entry.Name(),
+			VendorID:   normalizeID(read("idVendor")),
+			ProductID:  normalizeID(read("idProduct")),
+			Serial:     read("serial"),
+			Class:      normalizeID(read("bDeviceClass")),
+			USBVersion: read("version"),
+			Drivers:    []string{},
+		}
+		usb.Bus, _ = strconv.Atoi(read("busnum"))
+		usb.DeviceNumber, _ = strconv.Atoi(read("devnum"))
+		// speed is in Mbps and is fractional for low-speed devices, e.g. 1.5
+		usb.SpeedMbps, _ = strconv.ParseFloat(read("speed"), 64)
+
+		usb.Vendor, usb.Product = LookupUSB(usb.VendorID, usb.ProductID)
+		if usb.Vendor == "" {
+			usb.Vendor = read("manufacturer")
+		}
+		if usb.Product == "" {
+			usb.Product = read("product")
+		}
+
+		// Every device is bound to the generic "usb" driver; the interesting drivers bind to interfaces
+		if driver := sysfsDriver(device); driver != "" && driver != "usb" {
+			usb.Drivers = append(usb.Drivers, driver)
+		}
+		// Interface directories are named <port>:<config>.<interface>, with port <bus>-0 for root hubs
+		interfaces, _ := filepath.Glob(filepath.Join(device, "*:*"))
+		for _, iface := range interfaces {
+			// Class 00 means each interface declares its own class
+			if usb.Class == "00" {
+				if class := normalizeID(readSysfsString(filepath.Join(iface, "bInterfaceClass"))); class != "" {
+					usb.Class = class
+				}
+			}
+			if driver := sysfsDriver(iface); driver != "" && !containsString(usb.Drivers, driver) {
+				usb.Drivers = append(usb.Drivers, driver)
+			}
+		}
+		usb.ClassName = LookupUSBClass(usb.Class)
+
+		devices = append(devices, usb)
+	}
+
+	return devices, nil
+}
+
+// sysfsDriver returns the name of the driver bound to a sysfs device, or an empty string when none is bound
+func sysfsDriver(device string) string {
+	target, err := os.Readlink(filepath.Join(device, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(target)
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadPCIDevices(t *testing.T) {
+	const devices = "bus/pci/devices/"
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		links map[string]string // link name -> target, both relative to the root
+		want  []models.PCIDevice
+	}{
+		{
+			name: "listed and unlisted devices",
+			files: map[string]string{
+				devices + "0000:01:00.0/vendor":           "0xabcd\n",
+				devices + "0000:01:00.0/device":           "0x1234\n",
+				devices + "0000:01:00.0/class":            "0x020000\n",
+				devices + "0000:00:02.0/vendor":           "0x8086\n",
+				devices + "0000:00:02.0/device":           "0x46a6\n",
+				devices + "0000:00:02.0/subsystem_vendor": "0x1028\n",
+				devices + "0000:00:02.0/subsystem_device": "0x0b1a\n",
+				devices + "0000:00:02.0/class":            "0x030000\n",
+				devices + "0000:00:02.0/revision":         "0x0c\n",
+				devices + "0000:00:1f.0/vendor":           "0x8086\n",
+				devices + "0000:00:1f.0/device":           "0x7000\n",
+				devices + "0000:00:1f.0/class":            "0x06ff00\n",
+			},
+			links: map[string]string{
+				devices + "0000:00:02.0/driver": "bus/pci/drivers/i915",
+			},
+			want: []models.PCIDevice{
+				{
+					Address:           "0000:00:02.0",
+					VendorID:          "8086",
+					DeviceID:          "46a6",
+					Vendor:            "Intel Corporation",
+					Device:            "Alder Lake-P GT2 [Iris Xe Graphics]",
+					SubsystemVendorID: "1028",
+					SubsystemDeviceID: "0b1a",
+					Class:             "030000",
+					ClassName:         "VGA compatible controller",
+					Revision:          "0c",
+					Driver:            "i915",
+				},
+				{
+					Address:   "0000:00:1f.0",
+					VendorID:  "8086",
+					DeviceID:  "7000",
+					Vendor:    "Intel Corporation",
+					Device:    "82371SB PIIX3 ISA [Natoma/Triton II]",
+					Class:     "06ff00",
+					ClassName: "Bridge",
+				},
+				{
+					Address:   "0000:01:00.0",
+					VendorID:  "abcd",
+					DeviceID:  "1234",
+					Class:     "020000",
+					ClassName: "Ethernet controller",
+				},
+			},
+		},
+		{
+			name:  "no PCI bus",
+			files: map[string]string{},
+			want:  []models.PCIDevice{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeTree(t, tt.files)
+			for name, target := range tt.links {
+				symlink(t, root, filepath.Join(root, target), name)
+			}
+
+			got, err := ReadPCIDevices(root)
+			if err != nil {
+				t.Fatalf("ReadPCIDevices() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadPCIDevices() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadUSBDevices(t *testing.T) {
+	const devices = "bus/usb/devices/"
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		links map[string]string // link name -> target, both relative to the root
+		want  []models.USBDevice
+	}{
+		{
+			name: "root hub, composite device, and unlisted device",
+			files: map[string]string{
+				devices + "usb1/idVendor":                "1d6b\n",
+				devices + "usb1/idProduct":               "0002\n",
+				devices + "usb1/bDeviceClass":            "09\n",
+				devices + "usb1/busnum":                  "1\n",
+				devices + "usb1/devnum":                  "1\n",
+				devices + "usb1/speed":                   "480\n",
+				devices + "usb1/version":                 " 2.00\n",
+				devices + "usb1/manufacturer":            "Linux 6.1.0 xhci-hcd\n",
+				devices + "usb1/product":                 "xHCI Host Controller\n",
+				devices + "usb1/1-0:1.0/bInterfaceClass": "09\n",
+				devices + "1-0:1.0/bInterfaceClass":      "09\n",
+				devices + "1-2/idVendor":                 "046d\n",
+				devices + "1-2/idProduct":                "c548\n",
+				devices + "1-2/bDeviceClass":             "00\n",
+				devices + "1-2/busnum":                   "1\n",
+				devices + "1-2/devnum":                   "5\n",
+				devices + "1-2/speed":                    "12\n",
+				devices + "1-2/version":                  " 2.00\n",
+				devices + "1-2/product":                  "USB Receiver\n",
+				devices + "1-2/serial":                   "0123456789AB\n",
+				devices + "1-2/1-2:1.0/bInterfaceClass":  "03\n",
+				devices + "1-2/1-2:1.1/bInterfaceClass":  "03\n",
+				devices + "1-2.1/idVendor":               "abcd\n",
+				devices + "1-2.1/idProduct":              "0001\n",
+				devices + "1-2.1/bDeviceClass":           "ff\n",
+				devices + "1-2.1/busnum":                 "1\n",
+				devices + "1-2.1/devnum":                 "6\n",
+				devices + "1-2.1/speed":                  "1.5\n",
+				devices + "1-2.1/version":                " 1.10\n",
+				devices + "1-2.1/manufacturer":           "Acme\n",
+				devices + "1-2.1/product":                "Widget\n",
+			},
+			links: map[string]string{
+				devices + "usb1/driver":         "bus/usb/drivers/usb",
+				devices + "usb1/1-0:1.0/driver": "bus/usb/drivers/hub",
+				devices + "1-2/driver":          "bus/usb/drivers/usb",
+				devices + "1-2/1-2:1.0/driver":  "bus/usb/drivers/usbhid",
+				devices + "1-2/1-2:1.1/driver":  "bus/usb/drivers/usbhid",
+			},
+			want: []models.USBDevice{
+				{
+					Port:         "1-2",
+					Bus:          1,
+					DeviceNumber: 5,
+					VendorID:     "046d",
+					ProductID:    "c548",
+					Vendor:       "Logitech, Inc.",
+					Product:      "USB Receiver",
+					Serial:       "0123456789AB",
+					Class:        "03",
+					ClassName:    "Human Interface Device",
+					USBVersion:   "2.00",
+					SpeedMbps:    12,
+					Drivers:      []string{"usbhid"},
+				},
+				{
+					Port:         "1-2.1",
+					Bus:          1,
+					DeviceNumber: 6,
+					VendorID:     "abcd",
+					ProductID:    "0001",
+					Vendor:       "Acme",
+					Product:      "Widget",
+					Class:        "ff",
+					ClassName:    "Vendor Specific Class",
+					USBVersion:   "1.10",
+					SpeedMbps:    1.5,
+					Drivers:      []string{},
+				},
+				{
+					Port:         "usb1",
+					Bus:          1,
+					DeviceNumber: 1,
+					VendorID:     "1d6b",
+					ProductID:    "0002",
+					Vendor:       "Linux Foundation",
+					Product:      "2.0 root hub",
+					Class:        "09",
+					ClassName:    "Hub",
+					USBVersion:   "2.00",
+					SpeedMbps:    480,
+					Drivers:      []string{"hub"},
+				},
+			},
+		},
+		{
+			name:  "no USB controller",
+			files: map[string]string{},
+			want:  []models.USBDevice{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeTree(t, tt.files)
+			for name, target := range tt.links {
+				symlink(t, root, filepath.Join(root, target), name)
+			}
+
+			got, err := ReadUSBDevices(root)
+			if err != nil {
+				t.Fatalf("ReadUSBDevices() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadUSBDevices() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
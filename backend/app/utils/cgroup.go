@@ -0,0 +1,300 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// DefaultCgroupRoot is the cgroup filesystem mount point used when no override is configured
+const DefaultCgroupRoot = "/sys/fs/cgroup"
+
+// cgroupV1Unlimited is the smallest value cgroup v1 uses to mean no limit; the kernel reports
+// the largest page-aligned counter, which depends on the page size
+const cgroupV1Unlimited = 1 << 62
+
+// ReadCgroup reads the limits and usage of the cgroup the current process belongs to, as listed
+// in <procRoot>/self/cgroup, from the hierarchy mounted at cgroupRoot (Linux only).
+// A unified hierarchy is read as cgroup v2; otherwise the v1 controllers are read one by one.
+func ReadCgroup(procRoot, cgroupRoot string) (*models.Cgroup, error) {
+	paths, err := readCgroupPaths(filepath.Join(procRoot, "self", "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+
+	// cgroup.controllers only exists at the root of a unified (v2) hierarchy
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err == nil {
+		path, ok := paths[""]
+		if !ok {
+			return nil, errors.New("no cgroup v2 entry in proc cgroup")
+		}
+		return readCgroupV2(cgroupDir(cgroupRoot, path), path), nil
+	}
+
+	return readCgroupV1(cgroupRoot, paths)
+}
+
+// readCgroupPaths maps each controller to the process's cgroup path. The v2 entry has no
+// controllers and is stored under the empty name.
+func readCgroupPaths(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open proc cgroup: %w", err)
+	}
+	defer file.Close()
+
+	paths := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// Lines look like: 4:cpu,cpuacct:/docker/3f4e2a or 0::/user.slice
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[1] == "" {
+			paths[""] = fields[2]
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			paths[controller] = fields[2]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read proc cgroup: %w", err)
+	}
+
+	return paths, nil
+}
+
+// cgroupDir resolves a cgroup path below a mount point. Inside a cgroup namespace, or when only the
+// container's own cgroup is mounted, the path does not exist there and the mount itself is the cgroup.
+func cgroupDir(mount, path string) string {
+	dir := filepath.Join(mount, path)
+	if _, err := os.Stat(dir); err == nil {
+		return dir
+	}
+	return mount
+}
+
+// readCgroupV2 reads the cpu, memory, and pids interface files of a v2 cgroup
+func readCgroupV2(dir, path string) *models.Cgroup {
+	group := &models.Cgroup{Version: 2, Path: path}
+
+	// cpu.stat exists even without the cpu controller, reporting usage only
+	if stat := readCgroupKeyValues(filepath.Join(dir, "cpu.stat")); stat != nil {
+		cpu := &models.CgroupCPU{
+			PeriodMicros:     100000,
+			UsageSeconds:     float64(stat["usage_usec"]) / 1e6,
+			Periods:          stat["nr_periods"],
+			ThrottledPeriods: stat["nr_throttled"],
+			ThrottledSeconds: float64(stat["throttled_usec"]) / 1e6,
+		}
+		// cpu.max holds the quota and period, e.g. "200000 100000" or "max 100000"
+		if fields := strings.Fields(readSysfsString(filepath.Join(dir, "cpu.max"))); len(fields) == 2 {
+			if period, err := strconv.ParseInt(fields[1], 10, 64); err == nil && period > 0 {
+				cpu.PeriodMicros = period
+			}
+			if quota, err := strconv.ParseInt(fields[0], 10, 64); err == nil {
+				cpu.QuotaMicros = &quota
+			}
+		}
+		cpu.CpusetCPUs = countCPUList(readSysfsString(filepath.Join(dir, "cpuset.cpus.effective")))
+		cpu.EffectiveCPUs = effectiveCPUs(cpu)
+		group.CPU = cpu
+	}
+
+	if current, ok := readCgroupUint(filepath.Join(dir, "memory.current")); ok {
+		memory := &models.CgroupMemory{
+			UsageBytes:     current,
+			LimitBytes:     readCgroupLimit(filepath.Join(dir, "memory.max")),
+			SoftLimitBytes: readCgroupLimit(filepath.Join(dir, "memory.high")),
+		}
+		// memory.peak was added in Linux 5.19
+		if peak, ok := readCgroupUint(filepath.Join(dir, "memory.peak")); ok {
+			memory.PeakBytes = &peak
+		}
+		if events := readCgroupKeyValues(filepath.Join(dir, "memory.events")); events != nil {
+			memory.OOMEvents = events["oom"]
+			memory.OOMKills = events["oom_kill"]
+		}
+		group.Memory = memory
+	}
+
+	if current, ok := readCgroupUint(filepath.Join(dir, "pids.current")); ok {
+		group.Pids = &models.CgroupPids{
+			Current: current,
+			Limit:   readCgroupLimit(filepath.Join(dir, "pids.max")),
+		}
+	}
+
+	return group
+}
+
+// readCgroupV1 reads the cpu, cpuacct, cpuset, memory, and pids controllers of a v1 hierarchy.
+// Controllers that are not mounted are left out.
+func readCgroupV1(cgroupRoot string, paths map[string]string) (*models.Cgroup, error) {
+	group := &models.Cgroup{Version: 1}
+
+	controllerDir := func(controller string) (string, bool) {
+		path, ok := paths[controller]
+		if !ok {
+			return "", false
+		}
+		// Co-mounted controllers such as cpu,cpuacct are usually also reachable through a symlink per controller
+		mount := filepath.Join(cgroupRoot, controller)
+		if _, err := os.Stat(mount); err != nil {
+			return "", false
+		}
+		if group.Path == "" {
+			group.Path = path
+		}
+		return cgroupDir(mount, path), true
+	}
+
+	if dir, ok := controllerDir("cpu"); ok {
+		cpu := &models.CgroupCPU{PeriodMicros: 100000}
+		if period, ok := readCgroupUint(filepath.Join(dir, "cpu.cfs_period_us")); ok && period > 0 {
+			cpu.PeriodMicros = int64(period)
+		}
+		// A quota of -1 means unlimited
+		if quota, err := strconv.ParseInt(readSysfsString(filepath.Join(dir, "cpu.cfs_quota_us")), 10, 64); err == nil && quota > 0 {
+			cpu.QuotaMicros = &quota
+		}
+		if stat := readCgroupKeyValues(filepath.Join(dir, "cpu.stat")); stat != nil {
+			cpu.Periods = stat["nr_periods"]
+			cpu.ThrottledPeriods = stat["nr_throttled"]
+			cpu.ThrottledSeconds = float64(stat["throttled_time"]) / 1e9
+		}
+		if dir, ok := controllerDir("cpuacct"); ok {
+			if usage, ok := readCgroupUint(filepath.Join(dir, "cpuacct.usage")); ok {
+				cpu.UsageSeconds = float64(usage) / 1e9
+			}
+		}
+		if dir, ok := controllerDir("cpuset"); ok {
+			list := readSysfsString(filepath.Join(dir, "cpuset.effective_cpus"))
+			if list == "" {
+				list = readSysfsString(filepath.Join(dir, "cpuset.cpus"))
+			}
+			cpu.CpusetCPUs = countCPUList(list)
+		}
+		cpu.EffectiveCPUs = effectiveCPUs(cpu)
+		group.CPU = cpu
+	}
+
+	if dir, ok := controllerDir("memory"); ok {
+		if usage, ok := readCgroupUint(filepath.Join(dir, "memory.usage_in_bytes")); ok {
+			memory := &models.CgroupMemory{
+				UsageBytes:     usage,
+				LimitBytes:     readCgroupLimit(filepath.Join(dir, "memory.limit_in_bytes")),
+				SoftLimitBytes: readCgroupLimit(filepath.Join(dir, "memory.soft_limit_in_bytes")),
+			}
+			if peak, ok := readCgroupUint(filepath.Join(dir, "memory.max_usage_in_bytes")); ok {
+				memory.PeakBytes = &peak
+			}
+			// oom_kill was added to memory.oom_control in Linux 4.13; v1 does not count OOM events separately
+			if control := readCgroupKeyValues(filepath.Join(dir, "memory.oom_control")); control != nil {
+				memory.OOMKills = control["oom_kill"]
+				memory.OOMEvents = memory.OOMKills
+			}
+			group.Memory = memory
+		}
+	}
+
+	if dir, ok := controllerDir("pids"); ok {
+		if current, ok := readCgroupUint(filepath.Join(dir, "pids.current")); ok {
+			group.Pids = &models.CgroupPids{
+				Current: current,
+				Limit:   readCgroupLimit(filepath.Join(dir, "pids.max")),
+			}
+		}
+	}
+
+	if group.CPU == nil && group.Memory == nil && group.Pids == nil {
+		return nil, errors.New("no cgroup v1 controllers mounted")
+	}
+
+	return group, nil
+}
+
+// effectiveCPUs returns how many CPUs a cgroup can use: the lower of its quota and its cpuset,
+// or every CPU when neither limits it
+func effectiveCPUs(cpu *models.CgroupCPU) float64 {
+	cpus := float64(runtime.NumCPU())
+	if cpu.CpusetCPUs > 0 {
+		cpus = float64(cpu.CpusetCPUs)
+	}
+	if cpu.QuotaMicros != nil && cpu.PeriodMicros > 0 {
+		cpus = math.Min(cpus, float64(*cpu.QuotaMicros)/float64(cpu.PeriodMicros))
+	}
+	return cpus
+}
+
+// countCPUList counts the CPUs in a list such as "0-3,6,8-9", returning 0 when it is empty or malformed
+func countCPUList(list string) int {
+	count := 0
+	for _, part := range strings.Split(list, ",") {
+		if part == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			return 0
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(last); err != nil || end < start {
+				return 0
+			}
+		}
+		count += end - start + 1
+	}
+	return count
+}
+
+// readCgroupUint reads a single unsigned value, reporting false when the file is missing or malformed
+func readCgroupUint(path string) (uint64, bool) {
+	value, err := strconv.ParseUint(readSysfsString(path), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// readCgroupLimit reads a limit, returning nil when it is "max", the v1 unlimited value, or missing
+func readCgroupLimit(path string) *uint64 {
+	value, ok := readCgroupUint(path)
+	if !ok || value >= cgroupV1Unlimited {
+		return nil
+	}
+	return &value
+}
+
+// readCgroupKeyValues reads a flat keyed file such as cpu.stat or memory.events, returning nil when it is missing
+func readCgroupKeyValues(path string) map[string]uint64 {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	values := make(map[string]uint64)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if value, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = value
+		}
+	}
+	return values
+}
@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadCgroup(t *testing.T) {
+	int64Ptr := func(value int64) *int64 { return &value }
+	uint64Ptr := func(value uint64) *uint64 { return &value }
+	allCPUs := float64(runtime.NumCPU())
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		links   map[string]string // link name -> target, both relative to the root
+		want    *models.Cgroup
+		wantErr bool
+	}{
+		{
+			name: "v2 with limits",
+			files: map[string]string{
+				"proc/self/cgroup":                        "0::/docker/abc\n",
+				"cgroup/cgroup.controllers":               "cpuset cpu io memory pids\n",
+				"cgroup/docker/abc/cpu.stat":              "usage_usec 1500000\nuser_usec 1000000\nsystem_usec 500000\nnr_periods 10\nnr_throttled 2\nthrottled_usec 250000\n",
+				"cgroup/docker/abc/cpu.max":               "200000 100000\n",
+				"cgroup/docker/abc/cpuset.cpus.effective": "0-3\n",
+				"cgroup/docker/abc/memory.current":        "268435456\n",
+				"cgroup/docker/abc/memory.max":            "536870912\n",
+				"cgroup/docker/abc/memory.high":           "max\n",
+				"cgroup/docker/abc/memory.peak":           "314572800\n",
+				"cgroup/docker/abc/memory.events":         "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n",
+				"cgroup/docker/abc/pids.current":          "12\n",
+				"cgroup/docker/abc/pids.max":              "4096\n",
+			},
+			want: &models.Cgroup{
+				Version: 2,
+				Path:    "/docker/abc",
+				CPU: &models.CgroupCPU{
+					QuotaMicros:      int64Ptr(200000),
+					PeriodMicros:     100000,
+					CpusetCPUs:       4,
+					EffectiveCPUs:    2,
+					UsageSeconds:     1.5,
+					Periods:          10,
+					ThrottledPeriods: 2,
+					ThrottledSeconds: 0.25,
+				},
+				Memory: &models.CgroupMemory{
+					LimitBytes: uint64Ptr(536870912),
+					UsageBytes: 268435456,
+					PeakBytes:  uint64Ptr(314572800),
+					OOMEvents:  1,
+					OOMKills:   1,
+				},
+				Pids: &models.CgroupPids{Limit: uint64Ptr(4096), Current: 12},
+			},
+		},
+		{
+			name: "v2 unlimited inside a cgroup namespace",
+			files: map[string]string{
+				"proc/self/cgroup":          "0::/kubepods/pod1\n",
+				"cgroup/cgroup.controllers": "cpu memory pids\n",
+				"cgroup/cpu.stat":           "usage_usec 2000000\n",
+				"cgroup/cpu.max":            "max 100000\n",
+				"cgroup/memory.current":     "1048576\n",
+				"cgroup/memory.max":         "max\n",
+				"cgroup/pids.current":       "3\n",
+				"cgroup/pids.max":           "max\n",
+			},
+			want: &models.Cgroup{
+				Version: 2,
+				Path:    "/kubepods/pod1",
+				CPU: &models.CgroupCPU{
+					PeriodMicros:  100000,
+					EffectiveCPUs: allCPUs,
+					UsageSeconds:  2,
+				},
+				Memory: &models.CgroupMemory{UsageBytes: 1048576},
+				Pids:   &models.CgroupPids{Current: 3},
+			},
+		},
+		{
+			name: "v2 without a v2 entry",
+			files: map[string]string{
+				"proc/self/cgroup":          "4:cpu,cpuacct:/docker/abc\n",
+				"cgroup/cgroup.controllers": "cpu memory pids\n",
+			},
+			wantErr: true,
+		},
+		{
+			name: "v1 with co-mounted controllers",
+			files: map[string]string{
+				"proc/self/cgroup": "12:pids:/docker/abc\n4:cpu,cpuacct:/docker/abc\n3:cpuset:/docker/abc\n" +
+					"2:memory:/docker/abc\n1:name=systemd:/docker/abc\n",
+				"cgroup/cpu,cpuacct/docker/abc/cpu.cfs_period_us":     "100000\n",
+				"cgroup/cpu,cpuacct/docker/abc/cpu.cfs_quota_us":      "50000\n",
+				"cgroup/cpu,cpuacct/docker/abc/cpu.stat":              "nr_periods 20\nnr_throttled 5\nthrottled_time 2000000000\n",
+				"cgroup/cpu,cpuacct/docker/abc/cpuacct.usage":         "3000000000\n",
+				"cgroup/cpuset/docker/abc/cpuset.cpus":                "0-1\n",
+				"cgroup/memory/docker/abc/memory.usage_in_bytes":      "1048576\n",
+				"cgroup/memory/docker/abc/memory.limit_in_bytes":      "9223372036854771712\n",
+				"cgroup/memory/docker/abc/memory.soft_limit_in_bytes": "9223372036854771712\n",
+				"cgroup/memory/docker/abc/memory.max_usage_in_bytes":  "2097152\n",
+				"cgroup/memory/docker/abc/memory.oom_control":         "oom_kill_disable 0\nunder_oom 0\noom_kill 2\n",
+				"cgroup/pids/docker/abc/pids.current":                 "3\n",
+				"cgroup/pids/docker/abc/pids.max":                     "max\n",
+			},
+			links: map[string]string{
+				"cgroup/cpu":     "cgroup/cpu,cpuacct",
+				"cgroup/cpuacct": "cgroup/cpu,cpuacct",
+			},
+			want: &models.Cgroup{
+				Version: 1,
+				Path:    "/docker/abc",
+				CPU: &models.CgroupCPU{
+					QuotaMicros:      int64Ptr(50000),
+					PeriodMicros:     100000,
+					CpusetCPUs:       2,
+					EffectiveCPUs:    0.5,
+					UsageSeconds:     3,
+					Periods:          20,
+					ThrottledPeriods: 5,
+					ThrottledSeconds: 2,
+				},
+				Memory: &models.CgroupMemory{
+					UsageBytes: 1048576,
+					PeakBytes:  uint64Ptr(2097152),
+					OOMEvents:  2,
+					OOMKills:   2,
+				},
+				Pids: &models.CgroupPids{Current: 3},
+			},
+		},
+		{
+			name: "v1 with an unlimited quota",
+			files: map[string]string{
+				"proc/self/cgroup":                               "3:memory:/user.slice\n2:cpu:/user.slice\n",
+				"cgroup/cpu/user.slice/cpu.cfs_period_us":        "100000\n",
+				"cgroup/cpu/user.slice/cpu.cfs_quota_us":         "-1\n",
+				"cgroup/memory/user.slice/memory.usage_in_bytes": "4096\n",
+				"cgroup/memory/user.slice/memory.limit_in_bytes": "536870912\n",
+			},
+			want: &models.Cgroup{
+				Version: 1,
+				Path:    "/user.slice",
+				CPU:     &models.CgroupCPU{PeriodMicros: 100000, EffectiveCPUs: allCPUs},
+				Memory:  &models.CgroupMemory{LimitBytes: uint64Ptr(536870912), UsageBytes: 4096},
+			},
+		},
+		{
+			name: "v1 without mounted controllers",
+			files: map[string]string{
+				"proc/self/cgroup":     "4:cpu,cpuacct:/docker/abc\n",
+				"cgroup/systemd/tasks": "1\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing proc cgroup",
+			files:   map[string]string{"cgroup/cgroup.controllers": "cpu\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeTree(t, tt.files)
+			for name, target := range tt.links {
+				symlink(t, root, filepath.Join(root, target), name)
+			}
+
+			got, err := ReadCgroup(filepath.Join(root, "proc"), filepath.Join(root, "cgroup"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadCgroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadCgroup() = %s, want %s", describeCgroup(got), describeCgroup(tt.want))
+			}
+		})
+	}
+}
+
+// describeCgroup formats a cgroup with its sections dereferenced for test failure messages
+func describeCgroup(group *models.Cgroup) string {
+	if group == nil {
+		return "<nil>"
+	}
+	data, _ := json.Marshal(group)
+	return string(data)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultEtcRoot is the configuration directory used when no override is configured
+const DefaultEtcRoot = "/etc"
+
+// DefaultRunRoot is the runtime state directory used when no override is configured
+const DefaultRunRoot = "/run"
+
+// containerCgroupMarkers maps cgroup path fragments to the container runtime that creates them
+var containerCgroupMarkers = []struct {
+	marker  string
+	runtime string
+}{
+	{"kubepods", "kubernetes"},
+	{"libpod", "podman"},
+	{"docker", "docker"},
+	{"containerd", "containerd"},
+	{"lxc", "lxc"},
+}
+
+// DetectContainer reports the container runtime the current process runs under, or an empty
+// string when it does not appear to be containerized. It always inspects the process's own
+// filesystem, not the configured host roots, which describe the host being monitored.
+func DetectContainer() string {
+	// systemd-nspawn, podman, and LXC set the container variable for the init process
+	if runtime := os.Getenv("container"); runtime != "" {
+		return runtime
+	}
+	if _, err := os.Stat("/run/.containerenv"); err == nil {
+		return "podman"
+	}
+	if _, err := os.Stat("/.dockerenv"); err == nil {
+		return "docker"
+	}
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		return "kubernetes"
+	}
+
+	if data, err := os.ReadFile(filepath.Join(DefaultProcRoot, "self", "cgroup")); err == nil {
+		for _, candidate := range containerCgroupMarkers {
+			if strings.Contains(string(data), candidate.marker) {
+				return candidate.runtime
+			}
+		}
+	}
+
+	return ""
+}
+
+// ReadHostname reads the hostname from <etcRoot>/hostname, returning an empty string when it is missing
+func ReadHostname(etcRoot string) string {
+	return readSysfsString(filepath.Join(etcRoot, "hostname"))
+}
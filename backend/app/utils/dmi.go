@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// chassisTypes names the SMBIOS chassis type codes (SMBIOS 3.x, table 17)
+var chassisTypes = []string{
+	1: "Other", 2: "Unknown", 3: "Desktop", 4: "Low Profile Desktop", 5: "Pizza Box",
+	6: "Mini Tower", 7: "Tower", 8: "Portable", 9: "Laptop", 10: "Notebook",
+	11: "Hand Held", 12: "Docking Station", 13: "All in One", 14: "Sub Notebook", 15: "Space-saving",
+	16: "Lunch Box", 17: "Main Server Chassis", 18: "Expansion Chassis", 19: "SubChassis", 20: "Bus Expansion Chassis",
+	21: "Peripheral Chassis", 22: "RAID Chassis", 23: "Rack Mount Chassis", 24: "Sealed-case PC", 25: "Multi-system Chassis",
+	26: "Compact PCI", 27: "Advanced TCA", 28: "Blade", 29: "Blade Enclosure", 30: "Tablet",
+	31: "Convertible", 32: "Detachable", 33: "IoT Gateway", 34: "Embedded PC", 35: "Mini PC",
+	36: "Stick PC",
+}
+
+// dmiPlaceholders are values firmware vendors leave in fields they did not fill in
+var dmiPlaceholders = map[string]bool{
+	"to be filled by o.e.m.": true,
+	"default string":         true,
+	"not specified":          true,
+	"not applicable":         true,
+	"system product name":    true,
+	"system manufacturer":    true,
+	"system version":         true,
+	"system serial number":   true,
+	"none":                   true,
+}
+
+// ReadDMI reads the DMI/SMBIOS hardware inventory from <sysRoot>/class/dmi/id (Linux only).
+// Missing attributes are left empty, as are serial numbers, which only root can read.
+func ReadDMI(sysRoot string) *models.HardwareInfo {
+	dir := filepath.Join(sysRoot, "class", "dmi", "id")
+	read := func(name string) string {
+		value := readSysfsString(filepath.Join(dir, name))
+		if dmiPlaceholders[strings.ToLower(value)] {
+			return ""
+		}
+		return value
+	}
+
+	hardware := &models.HardwareInfo{
+		System: models.HardwareSystem{
+			Vendor:  read("sys_vendor"),
+			Product: read("product_name"),
+			Version: read("product_version"),
+			Family:  read("product_family"),
+			Serial:  read("product_serial"),
+		},
+		Board: models.HardwareBoard{
+			Vendor:  read("board_vendor"),
+			Name:    read("board_name"),
+			Version: read("board_version"),
+			Serial:  read("board_serial"),
+		},
+		BIOS: models.HardwareBIOS{
+			Vendor:  read("bios_vendor"),
+			Version: read("bios_version"),
+			Date:    read("bios_date"),
+		},
+		Chassis: models.HardwareChassis{
+			Vendor:  read("chassis_vendor"),
+			Version: read("chassis_version"),
+		},
+	}
+
+	if code, err := strconv.Atoi(read("chassis_type")); err == nil && code > 0 && code < len(chassisTypes) {
+		hardware.Chassis.Type = chassisTypes[code]
+	}
+
+	return hardware
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadDMI(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  *models.HardwareInfo
+	}{
+		{
+			name: "filled in by the vendor",
+			files: map[string]string{
+				"sys_vendor":      "Dell Inc.\n",
+				"product_name":    "XPS 15 9520\n",
+				"product_version": "1.0\n",
+				"product_family":  "XPS\n",
+				"product_serial":  "5CG1234XYZ\n",
+				"board_vendor":    "Dell Inc.\n",
+				"board_name":      "0RH1JY\n",
+				"board_version":   "A00\n",
+				"bios_vendor":     "Dell Inc.\n",
+				"bios_version":    "1.14.0\n",
+				"bios_date":       "03/12/2024\n",
+				"chassis_vendor":  "Dell Inc.\n",
+				"chassis_type":    "10\n",
+			},
+			want: &models.HardwareInfo{
+				System:  models.HardwareSystem{Vendor: "Dell Inc.", Product: "XPS 15 9520", Version: "1.0", Family: "XPS", Serial: "5CG1234XYZ"},
+				Board:   models.HardwareBoard{Vendor: "Dell Inc.", Name: "0RH1JY", Version: "A00"},
+				BIOS:    models.HardwareBIOS{Vendor: "Dell Inc.", Version: "1.14.0", Date: "03/12/2024"},
+				Chassis: models.HardwareChassis{Type: "Notebook", Vendor: "Dell Inc."},
+			},
+		},
+		{
+			name: "placeholders are dropped",
+			files: map[string]string{
+				"sys_vendor":      "System manufacturer\n",
+				"product_name":    "System Product Name\n",
+				"product_version": "System Version\n",
+				"product_family":  "To be filled by O.E.M.\n",
+				"product_serial":  "System Serial Number\n",
+				"board_vendor":    "ASUSTeK COMPUTER INC.\n",
+				"board_name":      "PRIME Z490-A\n",
+				"board_version":   "Rev 1.xx\n",
+				"board_serial":    "Default string\n",
+				"bios_vendor":     "American Megatrends Inc.\n",
+				"bios_version":    "2403\n",
+				"bios_date":       "Not Specified\n",
+				"chassis_vendor":  "Default string\n",
+				"chassis_version": "NONE\n",
+				"chassis_type":    "3\n",
+			},
+			want: &models.HardwareInfo{
+				Board:   models.HardwareBoard{Vendor: "ASUSTeK COMPUTER INC.", Name: "PRIME Z490-A", Version: "Rev 1.xx"},
+				BIOS:    models.HardwareBIOS{Vendor: "American Megatrends Inc.", Version: "2403"},
+				Chassis: models.HardwareChassis{Type: "Desktop"},
+			},
+		},
+		{
+			name: "unknown chassis type",
+			files: map[string]string{
+				"chassis_type": "99\n",
+			},
+			want: &models.HardwareInfo{},
+		},
+		{
+			name:  "no DMI table",
+			files: map[string]string{},
+			want:  &models.HardwareInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string, len(tt.files))
+			for name, content := range tt.files {
+				files["class/dmi/id/"+name] = content
+			}
+
+			got := ReadDMI(writeTree(t, files))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadDMI() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// drmCardPattern matches DRM card nodes and skips connectors such as card0-HDMI-A-1
+var drmCardPattern = regexp.MustCompile(`^card\d+$`)
+
+// ReadDRMGPUs enumerates GPUs from <sysRoot>/class/drm/card*/device (Linux only).
+// Names come from the bundled pci.ids subset; VRAM, busy percent, and clocks are
+// filled in when the driver exposes them (amdgpu, i915) and left "Unknown"/"N/A" otherwise.
+func ReadDRMGPUs(sysRoot string) ([]models.GPU, error) {
+	cards, err := filepath.Glob(filepath.Join(sysRoot, "class", "drm", "card*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DRM cards: %w", err)
+	}
+	sort.Slice(cards, func(i, j int) bool {
+		return drmCardNumber(cards[i]) < drmCardNumber(cards[j])
+	})
+
+	gpus := []models.GPU{}
+	seen := map[string]bool{}
+	for _, card := range cards {
+		if !drmCardPattern.MatchString(filepath.Base(card)) {
+			continue
+		}
+		device := filepath.Join(card, "device")
+		uevent := readUevent(filepath.Join(device, "uevent"))
+
+		// Boot framebuffers such as simpledrm have no PCI identity
+		vendorID := normalizeID(readSysfsString(filepath.Join(device, "vendor")))
+		deviceID := normalizeID(readSysfsString(filepath.Join(device, "device")))
+		if vendorID == "" || deviceID == "" {
+			continue
+		}
+
+		address := uevent["PCI_SLOT_NAME"]
+		if address == "" {
+			if target, err := os.Readlink(device); err == nil {
+				address = filepath.Base(target)
+			}
+		}
+		if address != "" {
+			if seen[address] {
+				continue
+			}
+			seen[address] = true
+		}
+
+		driver := uevent["DRIVER"]
+		if driver == "" {
+			if target, err := os.Readlink(filepath.Join(device, "driver")); err == nil {
+				driver = filepath.Base(target)
+			}
+		}
+
+		gpu := models.GPU{
+			Name:       pciDeviceName(vendorID, deviceID),
+			VRAM:       "Unknown",
+			Driver:     drmDriver(sysRoot, driver),
+			Usage:      "N/A",
+			ClockSpeed: "N/A",
+			PCIAddress: address,
+		}
+		if gpu.VRAMBytes = drmVRAM(device); gpu.VRAMBytes > 0 {
+			gpu.VRAM = FormatBytes(gpu.VRAMBytes, 1024)
+		}
+		if gpu.UsagePercent = drmBusyPercent(device); gpu.UsagePercent != nil {
+			gpu.Usage = formatPercent(*gpu.UsagePercent)
+		}
+		if gpu.ClockMHz = drmClock(card, device); gpu.ClockMHz > 0 {
+			gpu.ClockSpeed = fmt.Sprintf("%.0f MHz", gpu.ClockMHz)
+		}
+		gpus = append(gpus, gpu)
+	}
+
+	return gpus, nil
+}
+
+// pciDeviceName builds a display name from the bundled pci.ids subset, falling back to the raw IDs
+func pciDeviceName(vendorID, deviceID string) string {
+	vendor, device := LookupPCI(vendorID, deviceID)
+	switch {
+	case vendor != "" && device != "":
+		return vendor + " " + device
+	case vendor != "":
+		return fmt.Sprintf("%s Device %s", vendor, deviceID)
+	default:
+		return fmt.Sprintf("PCI Device %s:%s", vendorID, deviceID)
+	}
+}
+
+// drmDriver returns the kernel driver name with its module version when the module reports one
+func drmDriver(sysRoot, driver string) string {
+	if driver == "" {
+		return "Unknown"
+	}
+	if version := readSysfsString(filepath.Join(sysRoot, "module", driver, "version")); version != "" {
+		return driver + " " + version
+	}
+	return driver
+}
+
+// drmVRAM reads dedicated video memory in bytes from amdgpu's mem_info_vram_total, returning 0 when unknown
+func drmVRAM(device string) uint64 {
+	total, err := strconv.ParseUint(readSysfsString(filepath.Join(device, "mem_info_vram_total")), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return total
+}
+
+// drmBusyPercent reads amdgpu's gpu_busy_percent, returning nil when unknown
+func drmBusyPercent(device string) *float64 {
+	busy, err := strconv.ParseFloat(readSysfsString(filepath.Join(device, "gpu_busy_percent")), 64)
+	if err != nil {
+		return nil
+	}
+	return &busy
+}
+
+// drmClock reads the current shader clock in MHz from amdgpu's pp_dpm_sclk, where the active
+// level is marked with "*", or from i915's gt_cur_freq_mhz. It returns 0 when unknown.
+func drmClock(card, device string) float64 {
+	for _, line := range strings.Split(readSysfsString(filepath.Join(device, "pp_dpm_sclk")), "\n") {
+		if !strings.HasSuffix(strings.TrimSpace(line), "*") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		mhz := strings.TrimSuffix(strings.TrimSuffix(fields[1], "Mhz"), "MHz")
+		if clock, err := strconv.ParseFloat(mhz, 64); err == nil {
+			return clock
+		}
+	}
+
+	if mhz, err := strconv.ParseFloat(readSysfsString(filepath.Join(card, "gt_cur_freq_mhz")), 64); err == nil && mhz > 0 {
+		return mhz
+	}
+
+	return 0
+}
+
+// drmCardNumber extracts N from a cardN path so card10 sorts after card9
+func drmCardNumber(card string) int {
+	number, err := strconv.Atoi(strings.TrimPrefix(strings.SplitN(filepath.Base(card), "-", 2)[0], "card"))
+	if err != nil {
+		return -1
+	}
+	return number
+}
+
+// readUevent parses KEY=VALUE lines of a sysfs uevent file
+func readUevent(path string) map[string]string {
+	values := map[string]string{}
+	for _, line := range strings.Split(readSysfsString(path), "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
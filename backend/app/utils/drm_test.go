@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadDRMGPUs(t *testing.T) {
+	busy := float64(7)
+
+	const amdgpu = "devices/pci0000:00/0000:03:00.0"
+	const i915 = "devices/pci0000:00/0000:00:02.0"
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		links map[string]string // link name -> target, both relative to the root
+		want  []models.GPU
+	}{
+		{
+			name: "amdgpu",
+			files: map[string]string{
+				amdgpu + "/vendor":              "0x1002\n",
+				amdgpu + "/device":              "0x73bf\n",
+				amdgpu + "/uevent":              "DRIVER=amdgpu\nPCI_CLASS=30000\nPCI_SLOT_NAME=0000:03:00.0\n",
+				amdgpu + "/mem_info_vram_total": "17179869184\n",
+				amdgpu + "/gpu_busy_percent":    "7\n",
+				amdgpu + "/pp_dpm_sclk":         "0: 500Mhz\n1: 2250Mhz *\n",
+				"class/drm/card0-DP-1/status":   "connected\n",
+			},
+			links: map[string]string{"class/drm/card0/device": amdgpu},
+			want: []models.GPU{{
+				Name:       "Advanced Micro Devices, Inc. [AMD/ATI] Navi 21 [Radeon RX 6800/6800 XT / 6900 XT]",
+				VRAM:       "16.0 GB",
+				Driver:     "amdgpu",
+				Usage:      "7%",
+				ClockSpeed: "2250 MHz",
+				PCIAddress: "0000:03:00.0",
+
+				VRAMBytes:    17179869184,
+				UsagePercent: &busy,
+				ClockMHz:     2250,
+			}},
+		},
+		{
+			name: "i915 without uevent",
+			files: map[string]string{
+				i915 + "/vendor":                  "0x8086\n",
+				i915 + "/device":                  "0x46a6\n",
+				"class/drm/card1/gt_cur_freq_mhz": "1300\n",
+				"module/i915/version":             "1.6.0\n",
+			},
+			links: map[string]string{
+				"class/drm/card1/device": i915,
+				i915 + "/driver":         "bus/pci/drivers/i915",
+			},
+			want: []models.GPU{{
+				Name:       "Intel Corporation Alder Lake-P GT2 [Iris Xe Graphics]",
+				VRAM:       "Unknown",
+				Driver:     "i915 1.6.0",
+				Usage:      "N/A",
+				ClockSpeed: "1300 MHz",
+				PCIAddress: "0000:00:02.0",
+
+				ClockMHz: 1300,
+			}},
+		},
+		{
+			name: "unlisted IDs, framebuffers without PCI identity, and cards in numeric order",
+			files: map[string]string{
+				"devices/a/vendor":                    "0x8086\n",
+				"devices/a/device":                    "0xffff\n",
+				"devices/a/uevent":                    "PCI_SLOT_NAME=0000:00:02.0\n",
+				"devices/b/vendor":                    "0xabcd\n",
+				"devices/b/device":                    "0x1234\n",
+				"devices/b/uevent":                    "PCI_SLOT_NAME=0000:01:00.0\n",
+				"devices/simple-framebuffer.0/uevent": "DRIVER=simple-framebuffer\n",
+			},
+			links: map[string]string{
+				"class/drm/card0/device":  "devices/simple-framebuffer.0",
+				"class/drm/card10/device": "devices/b",
+				"class/drm/card2/device":  "devices/a",
+			},
+			want: []models.GPU{
+				{Name: "Intel Corporation Device ffff", VRAM: "Unknown", Driver: "Unknown", Usage: "N/A", ClockSpeed: "N/A", PCIAddress: "0000:00:02.0"},
+				{Name: "PCI Device abcd:1234", VRAM: "Unknown", Driver: "Unknown", Usage: "N/A", ClockSpeed: "N/A", PCIAddress: "0000:01:00.0"},
+			},
+		},
+		{
+			name: "cards sharing a device are reported once",
+			files: map[string]string{
+				amdgpu + "/vendor": "0x1002\n",
+				amdgpu + "/device": "0x73bf\n",
+				amdgpu + "/uevent": "DRIVER=amdgpu\nPCI_SLOT_NAME=0000:03:00.0\n",
+			},
+			links: map[string]string{
+				"class/drm/card0/device": amdgpu,
+				"class/drm/card1/device": amdgpu,
+			},
+			want: []models.GPU{{
+				Name:       "Advanced Micro Devices, Inc. [AMD/ATI] Navi 21 [Radeon RX 6800/6800 XT / 6900 XT]",
+				VRAM:       "Unknown",
+				Driver:     "amdgpu",
+				Usage:      "N/A",
+				ClockSpeed: "N/A",
+				PCIAddress: "0000:03:00.0",
+			}},
+		},
+		{
+			name:  "no DRM devices",
+			files: map[string]string{},
+			want:  []models.GPU{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeTree(t, tt.files)
+			for name, target := range tt.links {
+				symlink(t, root, filepath.Join(root, target), name)
+			}
+
+			got, err := ReadDRMGPUs(root)
+			if err != nil {
+				t.Fatalf("ReadDRMGPUs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadDRMGPUs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
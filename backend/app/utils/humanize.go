@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// humanUnits maps field name suffixes to the formatter applied by Humanize.
+// Longer suffixes come first so "_bytes_per_sec" wins over "_per_sec".
+var humanUnits = []struct {
+	suffix string
+	format func(value float64) string
+}{
+	{"_bytes_per_sec", func(v float64) string { return FormatBytes(uint64(v), 1000) + "/s" }},
+	{"_bytes", func(v float64) string { return FormatBytes(uint64(v), 1000) }},
+	{"_percent", func(v float64) string { return fmt.Sprintf("%.1f%%", v) }},
+	{"_mhz", func(v float64) string {
+		if v >= 1000 {
+			return fmt.Sprintf("%.2f GHz", v/1000)
+		}
+		return fmt.Sprintf("%.0f MHz", v)
+	}},
+	{"_per_sec", func(v float64) string { return fmt.Sprintf("%.1f/s", v) }},
+	{"_seconds", func(v float64) string { return (time.Duration(v) * time.Second).String() }},
+}
+
+// Humanize renders the numeric fields of a value as formatted strings, choosing the format from
+// the unit suffix of each JSON field name (e.g. total_bytes becomes "16.0 GB"). Other fields are kept.
+func Humanize(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed to decode value: %w", err)
+	}
+
+	return humanizeValue("", generic), nil
+}
+
+// humanizeValue formats value according to the field name it is stored under
+func humanizeValue(key string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for field, item := range v {
+			v[field] = humanizeValue(field, item)
+		}
+		return v
+	case []any:
+		// Arrays of numbers take the unit of their field, e.g. per_core_usage_percent
+		for i, item := range v {
+			v[i] = humanizeValue(key, item)
+		}
+		return v
+	case float64:
+		for _, unit := range humanUnits {
+			if strings.HasSuffix(key, unit.suffix) {
+				return unit.format(v)
+			}
+		}
+	}
+	return value
+}
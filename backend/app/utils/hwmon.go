@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// ReadHwmon reads fan speeds and voltage rails from <sysRoot>/class/hwmon (Linux only)
+func ReadHwmon(sysRoot string) ([]models.FanSensor, []models.VoltageSensor, error) {
+	chips, err := filepath.Glob(filepath.Join(sysRoot, "class", "hwmon", "hwmon*"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list hwmon devices: %w", err)
+	}
+	sort.Strings(chips)
+
+	fans := []models.FanSensor{}
+	voltages := []models.VoltageSensor{}
+	for _, chip := range chips {
+		// Some drivers keep their attributes in a device subdirectory
+		dir := chip
+		if _, err := os.Stat(filepath.Join(chip, "name")); err != nil {
+			if _, err := os.Stat(filepath.Join(chip, "device", "name")); err == nil {
+				dir = filepath.Join(chip, "device")
+			}
+		}
+		name := readSysfsString(filepath.Join(dir, "name"))
+		if name == "" {
+			name = filepath.Base(chip)
+		}
+
+		inputs, _ := filepath.Glob(filepath.Join(dir, "fan*_input"))
+		sort.Strings(inputs)
+		for _, input := range inputs {
+			base := strings.TrimSuffix(input, "_input")
+			rpm, ok := readHwmonValue(input)
+			if !ok {
+				continue
+			}
+			fan := models.FanSensor{
+				Chip:  name,
+				Label: hwmonLabel(base),
+				RPM:   rpm,
+			}
+			fan.Min, _ = readHwmonValue(base + "_min")
+			fan.Max, _ = readHwmonValue(base + "_max")
+			fans = append(fans, fan)
+		}
+
+		// Voltages are reported in millivolts
+		inputs, _ = filepath.Glob(filepath.Join(dir, "in*_input"))
+		sort.Strings(inputs)
+		for _, input := range inputs {
+			base := strings.TrimSuffix(input, "_input")
+			millivolts, ok := readHwmonValue(input)
+			if !ok {
+				continue
+			}
+			voltage := models.VoltageSensor{
+				Chip:  name,
+				Label: hwmonLabel(base),
+				Volts: millivolts / 1000,
+			}
+			if value, ok := readHwmonValue(base + "_min"); ok {
+				voltage.Min = value / 1000
+			}
+			if value, ok := readHwmonValue(base + "_max"); ok {
+				voltage.Max = value / 1000
+			}
+			if value, ok := readHwmonValue(base + "_crit"); ok {
+				voltage.Critical = value / 1000
+			}
+			voltages = append(voltages, voltage)
+		}
+	}
+
+	return fans, voltages, nil
+}
+
+// hwmonLabel returns the label of a hwmon channel, falling back to the channel name such as fan1
+func hwmonLabel(base string) string {
+	if label := readSysfsString(base + "_label"); label != "" {
+		return label
+	}
+	return filepath.Base(base)
+}
+
+// readHwmonValue reads a numeric hwmon attribute
+func readHwmonValue(path string) (float64, bool) {
+	value, err := strconv.ParseFloat(readSysfsString(path), 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadHwmon(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		wantFans     []models.FanSensor
+		wantVoltages []models.VoltageSensor
+	}{
+		{
+			name: "labeled channels with thresholds",
+			files: map[string]string{
+				"class/hwmon/hwmon0/name":       "nct6775\n",
+				"class/hwmon/hwmon0/fan1_input": "1200\n",
+				"class/hwmon/hwmon0/fan1_label": "CPU Fan\n",
+				"class/hwmon/hwmon0/fan1_min":   "300\n",
+				"class/hwmon/hwmon0/fan1_max":   "2500\n",
+				"class/hwmon/hwmon0/fan2_input": "0\n",
+				"class/hwmon/hwmon0/in0_input":  "1200\n",
+				"class/hwmon/hwmon0/in0_label":  "Vcore\n",
+				"class/hwmon/hwmon0/in0_min":    "800\n",
+				"class/hwmon/hwmon0/in0_max":    "1500\n",
+				"class/hwmon/hwmon0/in0_crit":   "1600\n",
+				"class/hwmon/hwmon0/in1_input":  "3300\n",
+			},
+			wantFans: []models.FanSensor{
+				{Chip: "nct6775", Label: "CPU Fan", RPM: 1200, Min: 300, Max: 2500},
+				{Chip: "nct6775", Label: "fan2", RPM: 0},
+			},
+			wantVoltages: []models.VoltageSensor{
+				{Chip: "nct6775", Label: "Vcore", Volts: 1.2, Min: 0.8, Max: 1.5, Critical: 1.6},
+				{Chip: "nct6775", Label: "in1", Volts: 3.3},
+			},
+		},
+		{
+			name: "attributes in the device subdirectory",
+			files: map[string]string{
+				"class/hwmon/hwmon1/device/name":       "it8728\n",
+				"class/hwmon/hwmon1/device/fan1_input": "900\n",
+			},
+			wantFans:     []models.FanSensor{{Chip: "it8728", Label: "fan1", RPM: 900}},
+			wantVoltages: []models.VoltageSensor{},
+		},
+		{
+			name: "unnamed chip and unreadable inputs",
+			files: map[string]string{
+				"class/hwmon/hwmon2/fan1_input": "\n",
+				"class/hwmon/hwmon2/in0_input":  "5000\n",
+			},
+			wantFans:     []models.FanSensor{},
+			wantVoltages: []models.VoltageSensor{{Chip: "hwmon2", Label: "in0", Volts: 5}},
+		},
+		{
+			name: "chips are read in order",
+			files: map[string]string{
+				"class/hwmon/hwmon1/name":       "b\n",
+				"class/hwmon/hwmon1/fan1_input": "2\n",
+				"class/hwmon/hwmon0/name":       "a\n",
+				"class/hwmon/hwmon0/fan1_input": "1\n",
+			},
+			wantFans:     []models.FanSensor{{Chip: "a", Label: "fan1", RPM: 1}, {Chip: "b", Label: "fan1", RPM: 2}},
+			wantVoltages: []models.VoltageSensor{},
+		},
+		{
+			name:         "no hwmon devices",
+			files:        map[string]string{},
+			wantFans:     []models.FanSensor{},
+			wantVoltages: []models.VoltageSensor{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fans, voltages, err := ReadHwmon(writeTree(t, tt.files))
+			if err != nil {
+				t.Fatalf("ReadHwmon() error = %v", err)
+			}
+			if !reflect.DeepEqual(fans, tt.wantFans) {
+				t.Errorf("ReadHwmon() fans = %+v, want %+v", fans, tt.wantFans)
+			}
+			if !reflect.DeepEqual(voltages, tt.wantVoltages) {
+				t.Errorf("ReadHwmon() voltages = %+v, want %+v", voltages, tt.wantVoltages)
+			}
+		})
+	}
+}
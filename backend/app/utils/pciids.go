@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed ids/pci.ids
+var pciIDsData string
+
+//go:embed ids/usb.ids
+var usbIDsData string
+
+// idEntry is a top-level entry of a pci.ids style database, a vendor or a device class,
+// with the devices or subclasses listed under it
+type idEntry struct {
+	name     string
+	children map[string]string
+}
+
+// idDatabase holds the vendors and classes parsed from a pci.ids style database
+type idDatabase struct {
+	vendors map[string]idEntry
+	classes map[string]idEntry
+}
+
+// lookup returns the name of a top-level entry and of a child listed under it
+func lookup(entries map[string]idEntry, id, childID string) (string, string) {
+	entry, ok := entries[normalizeID(id)]
+	if !ok {
+		return "", ""
+	}
+	return entry.name, entry.children[normalizeID(childID)]
+}
+
+var (
+	pciIDsOnce sync.Once
+	pciIDs     idDatabase
+
+	usbIDsOnce sync.Once
+	usbIDs     idDatabase
+)
+
+func loadPCIIDs() idDatabase {
+	pciIDsOnce.Do(func() {
+		pciIDs = parseIDDatabase(pciIDsData)
+	})
+	return pciIDs
+}
+
+func loadUSBIDs() idDatabase {
+	usbIDsOnce.Do(func() {
+		usbIDs = parseIDDatabase(usbIDsData)
+	})
+	return usbIDs
+}
+
+// LookupPCI returns the vendor and device names for lowercase hexadecimal PCI IDs
+// such as "10de" and "2206". Names are empty when the bundled database does not list them.
+func LookupPCI(vendorID, deviceID string) (string, string) {
+	return lookup(loadPCIIDs().vendors, vendorID, deviceID)
+}
+
+// LookupUSB returns the vendor and product names for hexadecimal USB IDs such as "046d" and "c52b".
+// Names are empty when the bundled database does not list them.
+func LookupUSB(vendorID, productID string) (string, string) {
+	return lookup(loadUSBIDs().vendors, vendorID, productID)
+}
+
+// LookupPCIClass names a PCI class and subclass such as "02" and "00", preferring the subclass name.
+// The name is empty when the bundled database lists neither.
+func LookupPCIClass(classID, subclassID string) string {
+	class, subclass := lookup(loadPCIIDs().classes, classID, subclassID)
+	if subclass != "" {
+		return subclass
+	}
+	return class
+}
+
+// LookupUSBClass names a USB base class such as "09", returning an empty string when it is not listed
+func LookupUSBClass(classID string) string {
+	class, _ := lookup(loadUSBIDs().classes, classID, "")
+	return class
+}
+
+// parseIDDatabase parses the vendor and device lines and the class and subclass lines of a
+// pci.ids style database. Subsystem entries, programming interfaces, and other lists are ignored.
+func parseIDDatabase(data string) idDatabase {
+	db := idDatabase{vendors: map[string]idEntry{}, classes: map[string]idEntry{}}
+	var current *idEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "\t\t"):
+			continue
+		case strings.HasPrefix(line, "\t"):
+			id, name, ok := strings.Cut(strings.TrimPrefix(line, "\t"), "  ")
+			if ok && current != nil {
+				current.children[strings.ToLower(id)] = strings.TrimSpace(name)
+			}
+		default:
+			id, name, ok := strings.Cut(line, "  ")
+			if !ok {
+				current = nil
+				continue
+			}
+			entries := db.vendors
+			// Class lines look like "C 02  Network controller"; other keyed lists such as
+			// usb.ids's "AT" or "HID" sections are skipped
+			if kind, classID, keyed := strings.Cut(id, " "); keyed {
+				if kind != "C" {
+					current = nil
+					continue
+				}
+				entries, id = db.classes, classID
+			}
+			entry := idEntry{name: strings.TrimSpace(name), children: map[string]string{}}
+			entries[strings.ToLower(id)] = entry
+			current = &entry
+		}
+	}
+
+	return db
+}
+
+// normalizeID converts IDs such as "0x10DE" to the "10de" form used by the databases
+func normalizeID(id string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(id), "0x"))
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIDDatabase(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want idDatabase
+	}{
+		{
+			name: "vendors and devices",
+			data: "# pci.ids\n" +
+				"\n" +
+				"10DE  NVIDIA Corporation\n" +
+				"\t2206  GA102 [GeForce RTX 3080]\n" +
+				"\t\t1458 403f  GeForce RTX 3080 Gaming OC\n" +
+				"\t1AEF  GA102 High Definition Audio Controller\n" +
+				"8086  Intel Corporation\n",
+			want: idDatabase{
+				vendors: map[string]idEntry{
+					"10de": {name: "NVIDIA Corporation", children: map[string]string{
+						"2206": "GA102 [GeForce RTX 3080]",
+						"1aef": "GA102 High Definition Audio Controller",
+					}},
+					"8086": {name: "Intel Corporation", children: map[string]string{}},
+				},
+				classes: map[string]idEntry{},
+			},
+		},
+		{
+			name: "classes and subclasses",
+			data: "C 02  Network controller\n" +
+				"\t00  Ethernet controller\n" +
+				"C 0C  Serial bus controller\n" +
+				"\t03  USB controller\n" +
+				"\t\t30  XHCI\n",
+			want: idDatabase{
+				vendors: map[string]idEntry{},
+				classes: map[string]idEntry{
+					"02": {name: "Network controller", children: map[string]string{"00": "Ethernet controller"}},
+					"0c": {name: "Serial bus controller", children: map[string]string{"03": "USB controller"}},
+				},
+			},
+		},
+		{
+			name: "other keyed lists are skipped with their entries",
+			data: "046d  Logitech, Inc.\n" +
+				"\tc52b  Unifying Receiver\n" +
+				"HID 01  Pointer\n" +
+				"\t01  ignored\n" +
+				"AT 0001  Audio terminal\n" +
+				"C 09  Hub\n",
+			want: idDatabase{
+				vendors: map[string]idEntry{
+					"046d": {name: "Logitech, Inc.", children: map[string]string{"c52b": "Unifying Receiver"}},
+				},
+				classes: map[string]idEntry{
+					"09": {name: "Hub", children: map[string]string{}},
+				},
+			},
+		},
+		{
+			name: "malformed lines",
+			data: "abcd Single space vendor\n" +
+				"\t0001  orphaned device\n" +
+				"1234  Vendor\n" +
+				"\tno separator\n",
+			want: idDatabase{
+				vendors: map[string]idEntry{
+					"1234": {name: "Vendor", children: map[string]string{}},
+				},
+				classes: map[string]idEntry{},
+			},
+		},
+		{
+			name: "empty",
+			data: "",
+			want: idDatabase{vendors: map[string]idEntry{}, classes: map[string]idEntry{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseIDDatabase(tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIDDatabase() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// ReadPowerSupplies reads AC adapter and battery state from <sysRoot>/class/power_supply (Linux only)
+func ReadPowerSupplies(sysRoot string) (*models.PowerStatus, error) {
+	supplies, err := filepath.Glob(filepath.Join(sysRoot, "class", "power_supply", "*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list power supplies: %w", err)
+	}
+	sort.Strings(supplies)
+
+	status := &models.PowerStatus{Batteries: []models.Battery{}}
+	for _, dir := range supplies {
+		switch readSysfsString(filepath.Join(dir, "type")) {
+		case "Mains", "USB":
+			online := readSysfsString(filepath.Join(dir, "online")) == "1"
+			if status.ACOnline == nil || online {
+				status.ACOnline = &online
+			}
+		case "Battery":
+			// Device scope marks peripheral batteries such as wireless mice
+			if readSysfsString(filepath.Join(dir, "scope")) == "Device" || readSysfsString(filepath.Join(dir, "present")) == "0" {
+				continue
+			}
+			status.Batteries = append(status.Batteries, readBattery(dir))
+		}
+	}
+
+	discharging := false
+	for _, battery := range status.Batteries {
+		if battery.Status == "Discharging" {
+			discharging = true
+		}
+	}
+	if status.ACOnline != nil {
+		status.OnBattery = !*status.ACOnline && len(status.Batteries) > 0
+	} else {
+		status.OnBattery = discharging
+	}
+
+	return status, nil
+}
+
+// readBattery reads one battery. Drivers report either energy (µWh) or charge (µAh) with voltage (µV).
+func readBattery(dir string) models.Battery {
+	battery := models.Battery{
+		Name:   filepath.Base(dir),
+		Status: readSysfsString(filepath.Join(dir, "status")),
+	}
+	if battery.Status == "" {
+		battery.Status = "Unknown"
+	}
+
+	voltage := readSupplyFloat(dir, "voltage_now") / 1e6
+	if voltage == 0 {
+		voltage = readSupplyFloat(dir, "voltage_min_design") / 1e6
+	}
+
+	// Convert µAh to µWh when only charge is reported
+	energy := func(name string) float64 {
+		if value := readSupplyFloat(dir, "energy_"+name); value > 0 {
+			return value / 1e6
+		}
+		return readSupplyFloat(dir, "charge_"+name) / 1e6 * voltage
+	}
+	battery.EnergyWh = energy("now")
+	battery.EnergyFullWh = energy("full")
+	battery.EnergyDesignWh = energy("full_design")
+
+	power := readSupplyFloat(dir, "power_now") / 1e6
+	if power == 0 {
+		power = readSupplyFloat(dir, "current_now") / 1e6 * voltage
+	}
+	// Some drivers report a signed value; the status decides the direction
+	power = math.Abs(power)
+	if battery.Status == "Discharging" && power > 0 {
+		battery.RateWatts = -power
+	} else {
+		battery.RateWatts = power
+	}
+
+	if capacity, ok := readSupplyValue(dir, "capacity"); ok {
+		battery.ChargePercent = capacity
+	} else if battery.EnergyFullWh > 0 {
+		battery.ChargePercent = battery.EnergyWh / battery.EnergyFullWh * 100
+	}
+	if battery.EnergyDesignWh > 0 {
+		battery.HealthPercent = battery.EnergyFullWh / battery.EnergyDesignWh * 100
+	}
+	battery.CycleCount = int(readSupplyFloat(dir, "cycle_count"))
+
+	switch battery.Status {
+	case "Discharging":
+		if seconds, ok := readSupplyValue(dir, "time_to_empty_now"); ok {
+			battery.TimeRemainingSeconds = int64(seconds)
+		} else if power > 0 {
+			battery.TimeRemainingSeconds = int64(battery.EnergyWh / power * 3600)
+		}
+	case "Charging":
+		if seconds, ok := readSupplyValue(dir, "time_to_full_now"); ok {
+			battery.TimeRemainingSeconds = int64(seconds)
+		} else if power > 0 && battery.EnergyFullWh > battery.EnergyWh {
+			battery.TimeRemainingSeconds = int64((battery.EnergyFullWh - battery.EnergyWh) / power * 3600)
+		}
+	}
+
+	return battery
+}
+
+// readSupplyValue reads a numeric power supply attribute
+func readSupplyValue(dir, name string) (float64, bool) {
+	value, err := strconv.ParseFloat(readSysfsString(filepath.Join(dir, name)), 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// readSupplyFloat reads a numeric power supply attribute, returning 0 when it is missing
+func readSupplyFloat(dir, name string) float64 {
+	value, _ := readSupplyValue(dir, name)
+	return value
+}
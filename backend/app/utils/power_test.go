@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadPowerSupplies(t *testing.T) {
+	online, offline := true, false
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  *models.PowerStatus
+	}{
+		{
+			name: "discharging battery reporting energy",
+			files: map[string]string{
+				"AC/type":                 "Mains\n",
+				"AC/online":               "0\n",
+				"BAT0/type":               "Battery\n",
+				"BAT0/status":             "Discharging\n",
+				"BAT0/present":            "1\n",
+				"BAT0/energy_now":         "40000000\n",
+				"BAT0/energy_full":        "50000000\n",
+				"BAT0/energy_full_design": "100000000\n",
+				"BAT0/power_now":          "10000000\n",
+				"BAT0/capacity":           "80\n",
+				"BAT0/cycle_count":        "312\n",
+			},
+			want: &models.PowerStatus{
+				ACOnline:  &offline,
+				OnBattery: true,
+				Batteries: []models.Battery{{
+					Name:                 "BAT0",
+					Status:               "Discharging",
+					ChargePercent:        80,
+					RateWatts:            -10,
+					EnergyWh:             40,
+					EnergyFullWh:         50,
+					EnergyDesignWh:       100,
+					HealthPercent:        50,
+					CycleCount:           312,
+					TimeRemainingSeconds: 14400,
+				}},
+			},
+		},
+		{
+			name: "charging battery reporting charge",
+			files: map[string]string{
+				"ADP1/type":               "Mains\n",
+				"ADP1/online":             "1\n",
+				"BAT1/type":               "Battery\n",
+				"BAT1/status":             "Charging\n",
+				"BAT1/voltage_now":        "12000000\n",
+				"BAT1/charge_now":         "2000000\n",
+				"BAT1/charge_full":        "4000000\n",
+				"BAT1/charge_full_design": "4000000\n",
+				"BAT1/current_now":        "2000000\n",
+			},
+			want: &models.PowerStatus{
+				ACOnline: &online,
+				Batteries: []models.Battery{{
+					Name:                 "BAT1",
+					Status:               "Charging",
+					ChargePercent:        50,
+					RateWatts:            24,
+					EnergyWh:             24,
+					EnergyFullWh:         48,
+					EnergyDesignWh:       48,
+					HealthPercent:        100,
+					TimeRemainingSeconds: 3600,
+				}},
+			},
+		},
+		{
+			name: "peripheral and absent batteries with several adapters",
+			files: map[string]string{
+				"ADP1/type":              "Mains\n",
+				"ADP1/online":            "0\n",
+				"ucsi-source-psy/type":   "USB\n",
+				"ucsi-source-psy/online": "1\n",
+				"hidpp_battery_0/type":   "Battery\n",
+				"hidpp_battery_0/scope":  "Device\n",
+				"hidpp_battery_0/status": "Discharging\n",
+				"BAT1/type":              "Battery\n",
+				"BAT1/present":           "0\n",
+			},
+			want: &models.PowerStatus{ACOnline: &online, Batteries: []models.Battery{}},
+		},
+		{
+			name: "no adapter with a signed rate",
+			files: map[string]string{
+				"BAT0/type":              "Battery\n",
+				"BAT0/status":            "Discharging\n",
+				"BAT0/energy_now":        "20000000\n",
+				"BAT0/energy_full":       "40000000\n",
+				"BAT0/power_now":         "-5000000\n",
+				"BAT0/time_to_empty_now": "7200\n",
+			},
+			want: &models.PowerStatus{
+				OnBattery: true,
+				Batteries: []models.Battery{{
+					Name:                 "BAT0",
+					Status:               "Discharging",
+					ChargePercent:        50,
+					RateWatts:            -5,
+					EnergyWh:             20,
+					EnergyFullWh:         40,
+					TimeRemainingSeconds: 7200,
+				}},
+			},
+		},
+		{
+			name: "battery without status",
+			files: map[string]string{
+				"BAT0/type": "Battery\n",
+			},
+			want: &models.PowerStatus{
+				Batteries: []models.Battery{{Name: "BAT0", Status: "Unknown"}},
+			},
+		},
+		{
+			name:  "no power supplies",
+			files: map[string]string{},
+			want:  &models.PowerStatus{Batteries: []models.Battery{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string, len(tt.files))
+			for name, content := range tt.files {
+				files["class/power_supply/"+name] = content
+			}
+
+			got, err := ReadPowerSupplies(writeTree(t, files))
+			if err != nil {
+				t.Fatalf("ReadPowerSupplies() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadPowerSupplies() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+// DefaultProcRoot is the procfs mount point used when no override is configured
+const DefaultProcRoot = "/proc"
+
+// ProcStatCounters represents cumulative counters from /proc/stat
+type ProcStatCounters struct {
+	ContextSwitches uint64
+	Interrupts      uint64
+}
+
+// ReadProcStatCounters reads the context switch and interrupt totals from <procRoot>/stat (Linux only)
+func ReadProcStatCounters(procRoot string) (*ProcStatCounters, error) {
+	file, err := os.Open(filepath.Join(procRoot, "stat"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open proc stat: %w", err)
+	}
+	defer file.Close()
+
+	counters := &ProcStatCounters{}
+	scanner := bufio.NewScanner(file)
+	// The intr line lists every interrupt and can exceed the default token size
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "ctxt":
+			counters.ContextSwitches, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "intr":
+			// The first value is the total; per-interrupt counts follow
+			counters.Interrupts, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read proc stat: %w", err)
+	}
+
+	return counters, nil
+}
+
+// VMStatCounters represents cumulative paging counters from /proc/vmstat
+type VMStatCounters struct {
+	SwapIn      uint64 // pages swapped in
+	SwapOut     uint64 // pages swapped out
+	MajorFaults uint64
+}
+
+// ReadVMStat reads swap and major fault counters from <procRoot>/vmstat (Linux only)
+func ReadVMStat(procRoot string) (*VMStatCounters, error) {
+	file, err := os.Open(filepath.Join(procRoot, "vmstat"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open vmstat: %w", err)
+	}
+	defer file.Close()
+
+	counters := &VMStatCounters{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "pswpin":
+			counters.SwapIn, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "pswpout":
+			counters.SwapOut, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "pgmajfault":
+			counters.MajorFaults, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read vmstat: %w", err)
+	}
+
+	return counters, nil
+}
+
+// ReadPressure reads pressure stall information for a resource (cpu, memory or io)
+// from <procRoot>/pressure (Linux 4.20+ with PSI enabled)
+func ReadPressure(procRoot, resource string) (*models.ResourcePressure, error) {
+	file, err := os.Open(filepath.Join(procRoot, "pressure", resource))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s pressure: %w", resource, err)
+	}
+	defer file.Close()
+
+	pressure := &models.ResourcePressure{}
+	found := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// Lines look like: some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		averages := models.PressureAverages{}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "avg10":
+				averages.Avg10, _ = strconv.ParseFloat(value, 64)
+			case "avg60":
+				averages.Avg60, _ = strconv.ParseFloat(value, 64)
+			case "avg300":
+				averages.Avg300, _ = strconv.ParseFloat(value, 64)
+			case "total":
+				averages.TotalMicros, _ = strconv.ParseUint(value, 10, 64)
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			pressure.Some = averages
+			found = true
+		case "full":
+			pressure.Full = &averages
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s pressure: %w", resource, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("no pressure data in %s", resource)
+	}
+
+	return pressure, nil
+}
+
+// DetectInitSystem names the init system from <runRoot>/systemd/system, which systemd creates at boot,
+// or from the command name of PID 1 in <procRoot> (Linux only), e.g. systemd, openrc-init, or tini
+func DetectInitSystem(procRoot, runRoot string) string {
+	if info, err := os.Stat(filepath.Join(runRoot, "systemd", "system")); err == nil && info.IsDir() {
+		return "systemd"
+	}
+	return readSysfsString(filepath.Join(procRoot, "1", "comm"))
+}
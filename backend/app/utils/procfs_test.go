@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kishansakhiya/wails-demo/backend/app/models"
+)
+
+func TestReadVMStat(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		want    *VMStatCounters
+		wantErr bool
+	}{
+		{
+			name: "counters",
+			files: map[string]string{"vmstat": "nr_free_pages 123456\n" +
+				"pswpin 42\n" +
+				"pswpout 17\n" +
+				"pgfault 99999\n" +
+				"pgmajfault 311\n"},
+			want: &VMStatCounters{SwapIn: 42, SwapOut: 17, MajorFaults: 311},
+		},
+		{
+			name:  "missing counters are zero",
+			files: map[string]string{"vmstat": "nr_free_pages 123456\n"},
+			want:  &VMStatCounters{},
+		},
+		{
+			name:  "malformed lines are skipped",
+			files: map[string]string{"vmstat": "pswpin\npswpout 5 extra\npgmajfault x\npswpin 8\n"},
+			want:  &VMStatCounters{SwapIn: 8},
+		},
+		{
+			name:    "missing file",
+			files:   map[string]string{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadVMStat(writeTree(t, tt.files))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadVMStat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadVMStat() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadPressure(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		files    map[string]string
+		want     *models.ResourcePressure
+		wantErr  bool
+	}{
+		{
+			name:     "some and full",
+			resource: "memory",
+			files: map[string]string{"pressure/memory": "some avg10=1.50 avg60=0.80 avg300=0.20 total=123456\n" +
+				"full avg10=0.50 avg60=0.25 avg300=0.05 total=4567\n"},
+			want: &models.ResourcePressure{
+				Some: models.PressureAverages{Avg10: 1.5, Avg60: 0.8, Avg300: 0.2, TotalMicros: 123456},
+				Full: &models.PressureAverages{Avg10: 0.5, Avg60: 0.25, Avg300: 0.05, TotalMicros: 4567},
+			},
+		},
+		{
+			name:     "cpu without full",
+			resource: "cpu",
+			files:    map[string]string{"pressure/cpu": "some avg10=12.00 avg60=6.00 avg300=3.00 total=999\n"},
+			want: &models.ResourcePressure{
+				Some: models.PressureAverages{Avg10: 12, Avg60: 6, Avg300: 3, TotalMicros: 999},
+			},
+		},
+		{
+			name:     "unknown keys are ignored",
+			resource: "io",
+			files:    map[string]string{"pressure/io": "some avg10=1.00 bogus avg5=7 total=10\n"},
+			want: &models.ResourcePressure{
+				Some: models.PressureAverages{Avg10: 1, TotalMicros: 10},
+			},
+		},
+		{
+			name:     "no some line",
+			resource: "io",
+			files:    map[string]string{"pressure/io": "full avg10=0.00 avg60=0.00 avg300=0.00 total=0\n"},
+			wantErr:  true,
+		},
+		{
+			name:     "PSI disabled",
+			resource: "cpu",
+			files:    map[string]string{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadPressure(writeTree(t, tt.files), tt.resource)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadPressure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadPressure() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultSysRoot is the sysfs mount point used when no override is configured
+const DefaultSysRoot = "/sys"
+
+// ReadLinkSettings reads the negotiated speed in Mbps and the duplex mode of a network
+// interface from <sysRoot>/class/net (Linux only). Speed is 0 and duplex empty when the
+// link is down, the interface is virtual, or sysfs is unavailable.
+func ReadLinkSettings(sysRoot, iface string) (int, string) {
+	dir := filepath.Join(sysRoot, "class", "net", iface)
+
+	speed := 0
+	// Reading speed fails with EINVAL while the link is down
+	if data, err := os.ReadFile(filepath.Join(dir, "speed")); err == nil {
+		if value, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil && value > 0 {
+			speed = value
+		}
+	}
+
+	duplex := ""
+	if data, err := os.ReadFile(filepath.Join(dir, "duplex")); err == nil {
+		if value := strings.TrimSpace(string(data)); value == "full" || value == "half" {
+			duplex = value
+		}
+	}
+
+	return speed, duplex
+}
+
+// readSysfsString reads a trimmed sysfs attribute, returning an empty string when it is missing
+func readSysfsString(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -42,17 +42,23 @@ func FormatBytes(bytes uint64, unitInt int) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
-// GetGPUInfo retrieves GPU information
-func GetGPUInfo() ([]models.GPU, error) {
+// formatPercent formats a percentage without trailing zeros, e.g. "30%" or "12.5%"
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + "%"
+}
+
+// GetGPUInfo retrieves GPU information. sysRoot is only used on Linux, where GPUs are read from sysfs.
+// Cancelling ctx stops any vendor tool still running.
+func GetGPUInfo(ctx context.Context, sysRoot string) ([]models.GPU, error) {
 	var gpus []models.GPU
 
 	switch runtime.GOOS {
 	case "linux":
-		gpus = getLinuxGPUInfo()
+		gpus = getLinuxGPUInfo(ctx, sysRoot)
 	case "windows":
-		gpus = getWindowsGPUInfo()
+		gpus = getWindowsGPUInfo(ctx)
 	case "darwin":
-		gpus = getDarwinGPUInfo()
+		gpus = getDarwinGPUInfo(ctx)
 	default:
 		return []models.GPU{}, fmt.Errorf("unsupported operating system: %s", runtime.GOOS)
 	}
@@ -60,40 +66,86 @@ func GetGPUInfo() ([]models.GPU, error) {
 	return gpus, nil
 }
 
-// getLinuxGPUInfo retrieves GPU information on Linux
-func getLinuxGPUInfo() []models.GPU {
-	var gpus []models.GPU
+// getLinuxGPUInfo retrieves GPU information on Linux from sysfs, using vendor tools
+// only when they are installed
+func getLinuxGPUInfo(ctx context.Context, sysRoot string) []models.GPU {
+	gpus, err := ReadDRMGPUs(sysRoot)
+	if err != nil || len(gpus) == 0 {
+		// Without DRM devices (e.g. a headless NVIDIA setup) fall back to the vendor tools
+		fallback := []models.GPU{}
+		if _, err := exec.LookPath("nvidia-smi"); err == nil {
+			fallback = append(fallback, getNvidiaGPUInfo(ctx)...)
+		}
+		if _, err := exec.LookPath("radeontop"); err == nil {
+			fallback = append(fallback, getAMDGPUInfo(ctx)...)
+		}
+		return fallback
+	}
 
-	// Try to get NVIDIA GPU info
-	if nvidiaGPUs := getNvidiaGPUInfo(); len(nvidiaGPUs) > 0 {
-		gpus = append(gpus, nvidiaGPUs...)
+	// The proprietary NVIDIA driver exposes no usage, memory, or clocks in sysfs
+	for _, gpu := range gpus {
+		if strings.HasPrefix(gpu.Driver, "nvidia") {
+			mergeNvidiaSMI(ctx, gpus)
+			break
+		}
 	}
 
-	// Try to get AMD GPU info
-	if amdGPUs := getAMDGPUInfo(); len(amdGPUs) > 0 {
-		gpus = append(gpus, amdGPUs...)
+	return gpus
+}
+
+// mergeNvidiaSMI fills VRAM, usage, clocks, and driver version of NVIDIA GPUs from nvidia-smi, matched by PCI address
+func mergeNvidiaSMI(ctx context.Context, gpus []models.GPU) {
+	if _, err := exec.LookPath("nvidia-smi"); err != nil {
+		return
 	}
 
-	// If no specific GPU info found, return generic info
-	if len(gpus) == 0 {
-		gpus = append(gpus, models.GPU{
-			Name:       "Generic GPU",
-			VRAM:       "Unknown",
-			Driver:     "Unknown",
-			Usage:      getGPUUsage(),
-			ClockSpeed: "N/A",
-		})
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "nvidia-smi", "--query-gpu=pci.bus_id,memory.total,driver_version,utilization.gpu,clocks.current.graphics", "--format=csv,noheader,nounits")
+	output, err := cmd.Output()
+	if err != nil {
+		return
 	}
 
-	return gpus
+	for line := range strings.SplitSeq(strings.TrimSpace(string(output)), "\n") {
+		parts := strings.Split(line, ", ")
+		if len(parts) < 5 {
+			continue
+		}
+		// nvidia-smi reports an eight-digit PCI domain ("00000000:01:00.0"), sysfs a four-digit one
+		address := strings.ToLower(strings.TrimSpace(parts[0]))
+		if len(address) > 12 {
+			address = address[len(address)-12:]
+		}
+		for i := range gpus {
+			if gpus[i].PCIAddress != address {
+				continue
+			}
+			// memory.total is reported in MiB
+			if ram, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64); err == nil {
+				gpus[i].VRAM = fmt.Sprintf("%d GB", ram/1024)
+				gpus[i].VRAMBytes = ram * 1024 * 1024
+			}
+			gpus[i].Driver = "nvidia " + strings.TrimSpace(parts[2])
+			if usage, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64); err == nil {
+				gpus[i].Usage = formatPercent(usage)
+				gpus[i].UsagePercent = &usage
+			}
+			if clock, err := strconv.ParseFloat(strings.TrimSpace(parts[4]), 64); err == nil {
+				gpus[i].ClockSpeed = fmt.Sprintf("%.0f MHz", clock)
+				gpus[i].ClockMHz = clock
+			}
+		}
+	}
 }
 
 // getNvidiaGPUInfo retrieves NVIDIA GPU information
-func getNvidiaGPUInfo() []models.GPU {
+func getNvidiaGPUInfo(ctx context.Context) []models.GPU {
 	var gpus []models.GPU
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Check if nvidia-smi is available
@@ -108,14 +160,20 @@ func getNvidiaGPUInfo() []models.GPU {
 	for line := range lines {
 		parts := strings.Split(line, ", ")
 		if len(parts) >= 5 {
-			ram, _ := strconv.Atoi(strings.TrimSpace(parts[1]))
+			// memory.total is reported in MiB
+			ram, _ := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
 			gpu := models.GPU{
 				Name:       strings.TrimSpace(parts[0]),
 				VRAM:       fmt.Sprintf("%d GB", ram/1024),
 				Driver:     strings.TrimSpace(parts[2]),
 				Usage:      strings.TrimSpace(parts[3]) + "%",
 				ClockSpeed: strings.TrimSpace(parts[4]) + " MHz",
+				VRAMBytes:  ram * 1024 * 1024,
+			}
+			if usage, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64); err == nil {
+				gpu.UsagePercent = &usage
 			}
+			gpu.ClockMHz, _ = strconv.ParseFloat(strings.TrimSpace(parts[4]), 64)
 			gpus = append(gpus, gpu)
 		}
 	}
@@ -124,11 +182,11 @@ func getNvidiaGPUInfo() []models.GPU {
 }
 
 // getAMDGPUInfo retrieves AMD GPU information
-func getAMDGPUInfo() []models.GPU {
+func getAMDGPUInfo(ctx context.Context) []models.GPU {
 	var gpus []models.GPU
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Check if radeontop is available
@@ -142,12 +200,14 @@ func getAMDGPUInfo() []models.GPU {
 	lines := strings.SplitSeq(strings.TrimSpace(string(output)), "\n")
 	for line := range lines {
 		if strings.Contains(line, "gpu") {
+			usage := getGPUUsage(ctx)
 			gpu := models.GPU{
-				Name:       "AMD GPU",
-				VRAM:       "Unknown",
-				Driver:     "AMD",
-				Usage:      getGPUUsage(),
-				ClockSpeed: "N/A",
+				Name:         "AMD GPU",
+				VRAM:         "Unknown",
+				Driver:       "AMD",
+				Usage:        formatPercent(usage),
+				ClockSpeed:   "N/A",
+				UsagePercent: &usage,
 			}
 			gpus = append(gpus, gpu)
 			break
@@ -158,16 +218,16 @@ func getAMDGPUInfo() []models.GPU {
 }
 
 // getWindowsGPUInfo retrieves GPU information on Windows
-func getWindowsGPUInfo() []models.GPU {
+func getWindowsGPUInfo(ctx context.Context) []models.GPU {
 	var gpus []models.GPU
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Use PowerShell to get GPU info including clock speed
 	// Use -WindowStyle Hidden to prevent PowerShell window from appearing
-	cmd := 	exec.CommandContext(ctx, "powershell", "-WindowStyle", "Hidden", "-Command", `
+	cmd := exec.CommandContext(ctx, "powershell", "-WindowStyle", "Hidden", "-Command", `
 		Get-WmiObject -Class Win32_VideoController | Where-Object {$_.Name -ne $null} | ForEach-Object {
 			[PSCustomObject]@{
 				Name = $_.Name
@@ -182,29 +242,33 @@ func getWindowsGPUInfo() []models.GPU {
 	output, err := cmd.Output()
 	if err != nil {
 		// Fallback: return a generic GPU entry
+		usage := getGPUUsage(ctx)
 		return []models.GPU{{
-			Name:       "Generic GPU",
-			VRAM:       "Unknown",
-			Driver:     "Unknown",
-			Usage:      getGPUUsage(),
-			ClockSpeed: "N/A",
+			Name:         "Generic GPU",
+			VRAM:         "Unknown",
+			Driver:       "Unknown",
+			Usage:        formatPercent(usage),
+			ClockSpeed:   "N/A",
+			UsagePercent: &usage,
 		}}
 	}
 
 	// Handle both single object and array responses
 	var gpuData any
 	if err := json.Unmarshal(output, &gpuData); err != nil {
+		usage := getGPUUsage(ctx)
 		return []models.GPU{{
-			Name:       "Generic GPU",
-			VRAM:       "Unknown",
-			Driver:     "Unknown",
-			Usage:      getGPUUsage(),
-			ClockSpeed: "N/A",
+			Name:         "Generic GPU",
+			VRAM:         "Unknown",
+			Driver:       "Unknown",
+			Usage:        formatPercent(usage),
+			ClockSpeed:   "N/A",
+			UsagePercent: &usage,
 		}}
 	}
 
 	// Get GPU usage once for all GPUs
-	gpuUsage := getGPUUsage()
+	gpuUsage := getGPUUsage(ctx)
 
 	// Handle single GPU object
 	if gpuMap, ok := gpuData.(map[string]any); ok {
@@ -219,13 +283,7 @@ func getWindowsGPUInfo() []models.GPU {
 		}
 
 		if name != "" {
-			gpus = append(gpus, models.GPU{
-				Name:       name,
-				VRAM:       fmt.Sprintf("%.2f GB", ram/1024/1024),
-				Driver:     driver,
-				Usage:      gpuUsage,
-				ClockSpeed: clockSpeed,
-			})
+			gpus = append(gpus, windowsGPU(name, driver, clockSpeed, ram, gpuUsage))
 		}
 	}
 
@@ -244,13 +302,7 @@ func getWindowsGPUInfo() []models.GPU {
 				}
 
 				if name != "" {
-					gpus = append(gpus, models.GPU{
-						Name:       name,
-						VRAM:       fmt.Sprintf("%.2f GB", ram/1024/1024),
-						Driver:     driver,
-						Usage:      gpuUsage,
-						ClockSpeed: clockSpeed,
-					})
+					gpus = append(gpus, windowsGPU(name, driver, clockSpeed, ram, gpuUsage))
 				}
 			}
 		}
@@ -259,23 +311,41 @@ func getWindowsGPUInfo() []models.GPU {
 	// If no GPUs found, return a generic one
 	if len(gpus) == 0 {
 		gpus = append(gpus, models.GPU{
-			Name:       "Generic GPU",
-			VRAM:       "Unknown",
-			Driver:     "Unknown",
-			Usage:      gpuUsage,
-			ClockSpeed: "N/A",
+			Name:         "Generic GPU",
+			VRAM:         "Unknown",
+			Driver:       "Unknown",
+			Usage:        formatPercent(gpuUsage),
+			ClockSpeed:   "N/A",
+			UsagePercent: &gpuUsage,
 		})
 	}
 
 	return gpus
 }
 
+// windowsGPU builds a GPU from a Win32_VideoController entry, whose AdapterRAM is in bytes
+func windowsGPU(name, driver, clockSpeed string, ram, usage float64) models.GPU {
+	gpu := models.GPU{
+		Name:         name,
+		VRAM:         "Unknown",
+		Driver:       driver,
+		Usage:        formatPercent(usage),
+		ClockSpeed:   clockSpeed,
+		UsagePercent: &usage,
+	}
+	if ram > 0 {
+		gpu.VRAMBytes = uint64(ram)
+		gpu.VRAM = FormatBytes(gpu.VRAMBytes, 1024)
+	}
+	return gpu
+}
+
 // getDarwinGPUInfo retrieves GPU information on macOS
-func getDarwinGPUInfo() []models.GPU {
+func getDarwinGPUInfo(ctx context.Context) []models.GPU {
 	var gpus []models.GPU
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Use system_profiler to get GPU info
@@ -291,21 +361,23 @@ func getDarwinGPUInfo() []models.GPU {
 	}
 
 	// Parse system_profiler output (simplified)
+	usage := getGPUUsage(ctx)
 	gpus = append(gpus, models.GPU{
-		Name:       "macOS GPU",
-		VRAM:       "Unknown",
-		Driver:     "macOS",
-		Usage:      getGPUUsage(),
-		ClockSpeed: "N/A",
+		Name:         "macOS GPU",
+		VRAM:         "Unknown",
+		Driver:       "macOS",
+		Usage:        formatPercent(usage),
+		ClockSpeed:   "N/A",
+		UsagePercent: &usage,
 	})
 
 	return gpus
 }
 
-// GetLocationInfo retrieves location information
-func GetLocationInfo() (models.Location, error) {
+// GetLocationInfo retrieves location information, giving up when ctx is done
+func GetLocationInfo(ctx context.Context) (models.Location, error) {
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Create HTTP request with context
@@ -342,33 +414,33 @@ func GetLocationInfo() (models.Location, error) {
 	return location, nil
 }
 
-// getGPUUsage retrieves GPU utilization percentage
-func getGPUUsage() string {
+// getGPUUsage retrieves GPU utilization percentage, returning 0 when no tool reports it
+func getGPUUsage(ctx context.Context) float64 {
 	// Try nvidia-smi first (for NVIDIA GPUs)
-	usage := getNvidiaGPUUsage()
-	if usage != "0%" {
+	usage := getNvidiaGPUUsage(ctx)
+	if usage != 0 {
 		return usage
 	}
 
 	// Try AMD GPU usage
-	usage = getAMDGPUUsage()
-	if usage != "0%" {
+	usage = getAMDGPUUsage(ctx)
+	if usage != 0 {
 		return usage
 	}
 
 	// Try Intel GPU usage
-	usage = getIntelGPUUsage()
-	if usage != "0%" {
+	usage = getIntelGPUUsage(ctx)
+	if usage != 0 {
 		return usage
 	}
 
 	// Fallback to PowerShell method for Windows
-	return getWindowsGPUUsage()
+	return getWindowsGPUUsage(ctx)
 }
 
 // getNvidiaGPUUsage gets GPU usage from nvidia-smi
-func getNvidiaGPUUsage() string {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func getNvidiaGPUUsage(ctx context.Context) float64 {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	cmdString := "nvidia-smi --query-gpu=utilization.gpu --format=csv,noheader,nounits"
@@ -376,21 +448,21 @@ func getNvidiaGPUUsage() string {
 	applyWindowsNoWindow(cmd)
 	output, err := cmd.Output()
 	if err != nil {
-		return "0%"
+		return 0
 	}
 
 	// Parse the output
 	usageStr := strings.TrimSpace(string(output))
 	if usage, err := strconv.Atoi(usageStr); err == nil {
-		return fmt.Sprintf("%d%%", usage)
+		return float64(usage)
 	}
 
-	return "0%"
+	return 0
 }
 
 // getAMDGPUUsage gets GPU usage from AMD tools
-func getAMDGPUUsage() string {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func getAMDGPUUsage(ctx context.Context) float64 {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Try radeontop if available
@@ -399,17 +471,17 @@ func getAMDGPUUsage() string {
 	applyWindowsNoWindow(cmd)
 	_, err := cmd.Output()
 	if err != nil {
-		return "0%"
+		return 0
 	}
 
 	// Parse radeontop output (complex parsing would be needed)
 	// For now, return a placeholder
-	return "0%"
+	return 0
 }
 
 // getIntelGPUUsage gets GPU usage from Intel tools
-func getIntelGPUUsage() string {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func getIntelGPUUsage(ctx context.Context) float64 {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Try intel_gpu_top if available
@@ -418,17 +490,17 @@ func getIntelGPUUsage() string {
 	applyWindowsNoWindow(cmd)
 	_, err := cmd.Output()
 	if err != nil {
-		return "0%"
+		return 0
 	}
 
 	// Parse intel_gpu_top output (complex parsing would be needed)
 	// For now, return a placeholder
-	return "0%"
+	return 0
 }
 
 // getWindowsGPUUsage gets GPU usage using PowerShell on Windows
-func getWindowsGPUUsage() string {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func getWindowsGPUUsage(ctx context.Context) float64 {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Try multiple methods to get GPU usage on Windows
@@ -439,7 +511,7 @@ func getWindowsGPUUsage() string {
 	if output, err := cmd.Output(); err == nil {
 		usageStr := strings.TrimSpace(string(output))
 		if usage, err := strconv.Atoi(usageStr); err == nil {
-			return fmt.Sprintf("%d%%", usage)
+			return float64(usage)
 		}
 	}
 
@@ -472,12 +544,12 @@ func getWindowsGPUUsage() string {
 	applyWindowsNoWindow(cmd)
 	output, err := cmd.Output()
 	if err != nil {
-		return "0%"
+		return 0
 	}
 
 	usageStr := strings.TrimSpace(string(output))
 	if usage, err := strconv.ParseFloat(usageStr, 64); err == nil && usage > 0 {
-		return fmt.Sprintf("%.1f%%", usage)
+		return usage
 	}
 
 	// Method 3: Try WMI for GPU utilization (fallback)
@@ -499,13 +571,13 @@ func getWindowsGPUUsage() string {
 	applyWindowsNoWindow(cmd)
 	output, err = cmd.Output()
 	if err != nil {
-		return "0%"
+		return 0
 	}
 
 	usageStr = strings.TrimSpace(string(output))
 	if usage, err := strconv.ParseFloat(usageStr, 64); err == nil && usage > 0 {
-		return fmt.Sprintf("%.1f%%", usage)
+		return usage
 	}
 
-	return "0%"
+	return 0
 }
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ReadTimezone returns the name of the system timezone and its current offset from UTC in seconds.
+// Unix systems are read from <etcRoot>/timezone or the <etcRoot>/localtime symlink, falling back to
+// TZ; Windows reports its registry timezone name. When no name is found, the zone abbreviation is used.
+func ReadTimezone(etcRoot string) (string, int) {
+	name := platformTimezone()
+	if name == "" {
+		name = readSysfsString(filepath.Join(etcRoot, "timezone"))
+	}
+	if name == "" {
+		// localtime links into the zoneinfo database, e.g. /usr/share/zoneinfo/Europe/Berlin
+		if target, err := os.Readlink(filepath.Join(etcRoot, "localtime")); err == nil {
+			if _, zone, ok := strings.Cut(filepath.ToSlash(target), "zoneinfo/"); ok {
+				name = zone
+			}
+		}
+	}
+	if name == "" {
+		name = strings.TrimPrefix(os.Getenv("TZ"), ":")
+	}
+
+	now := time.Now()
+	// The offset of a named zone is computed for that zone, which may differ from our own when reading a host's /etc
+	if location, err := time.LoadLocation(name); name != "" && err == nil {
+		_, offset := now.In(location).Zone()
+		return name, offset
+	}
+
+	abbreviation, offset := now.Zone()
+	if name == "" {
+		name = abbreviation
+	}
+	return name, offset
+}
+
+// ReadLocale returns the system locale, e.g. en_US.UTF-8. Unix systems are read from LANG in
+// <etcRoot>/locale.conf or <etcRoot>/default/locale, falling back to LC_ALL and LANG in our own
+// environment; Windows reports the preferred UI language.
+func ReadLocale(etcRoot string) string {
+	if locale := platformLocale(); locale != "" {
+		return locale
+	}
+
+	for _, path := range []string{
+		filepath.Join(etcRoot, "locale.conf"),
+		filepath.Join(etcRoot, "default", "locale"),
+	} {
+		if locale := readShellVariable(path, "LANG"); locale != "" {
+			return locale
+		}
+	}
+
+	for _, key := range []string{"LC_ALL", "LANG"} {
+		if locale := os.Getenv(key); locale != "" {
+			return locale
+		}
+	}
+	return ""
+}
+
+// readShellVariable reads a KEY=value assignment from a shell-style file, returning an empty string when it is missing
+func readShellVariable(path, key string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if ok && name == key {
+			return strings.Trim(value, `"'`)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package utils
+
+// platformTimezone returns an empty string; Unix timezones are read from /etc
+func platformTimezone() string {
+	return ""
+}
+
+// platformLocale returns an empty string; Unix locales are read from /etc and the environment
+func platformLocale() string {
+	return ""
+}
@@ -45,7 +45,7 @@ func main() {
 	r := gin.New()
 
 	// Setup API routes
-	routes.SetupRoutes(r)
+	routes.SetupRoutes(r, cfg)
 
 	// Create server address
 	serverAddr := cfg.GetServerAddress()
@@ -53,9 +53,12 @@ func main() {
 	// Log startup information
 	log.Printf("Starting System Benchmark API server on %s", serverAddr)
 	log.Printf("Server mode: %s", cfg.Server.Mode)
-	log.Printf("Cache enabled: %v (TTL: %ds)", cfg.Cache.Enabled, cfg.Cache.TTL)
+	log.Printf("Cache enabled: %v (default TTL: %ds, max entries: %d)", cfg.Cache.Enabled, cfg.Cache.TTL, cfg.Cache.MaxSize)
 	log.Printf("Rate limiting enabled: %v (%d requests per %ds)",
 		cfg.RateLimit.Enabled, cfg.RateLimit.Limit, cfg.RateLimit.Window)
+	log.Printf("Usage history enabled: %v (every %ds, kept %dh)",
+		cfg.History.Enabled, cfg.History.Interval, cfg.History.Retention)
+	log.Printf("Metrics enabled: %v (%s)", cfg.Metrics.Enabled, cfg.Metrics.Path)
 
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/cpu": {
+        "/api/v1/cgroup": {
             "get": {
-                "description": "Retrieve detailed CPU information including cores, frequency, and usage",
+                "description": "Retrieve the CPU quota and period, effective CPU count, throttling, memory limit, usage and peak, OOM events, and pids limit of the cgroup the API runs in (Linux cgroup v1 or v2)",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,14 +34,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cpu"
+                    "cgroup"
+                ],
+                "summary": "Get cgroup limits",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get CPU information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CPUInfo"
+                            "$ref": "#/definitions/models.Cgroup"
                         }
                     },
                     "500": {
@@ -53,9 +61,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/disk": {
+        "/api/v1/collectors": {
             "get": {
-                "description": "Retrieve disk information including partitions, usage, and I/O statistics",
+                "description": "List every registered collector with how long its results stay fresh",
                 "consumes": [
                     "application/json"
                 ],
@@ -63,28 +71,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "disk"
+                    "collectors"
                 ],
-                "summary": "Get disk information",
+                "summary": "List collectors",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DiskInfo"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CollectorInfo"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/v1/gpu": {
+        "/api/v1/collectors/{name}": {
             "get": {
-                "description": "Retrieve GPU information including model, memory, and driver details",
+                "description": "Run one registered collector by name and return its data, e.g. cpu, memory, sensors",
                 "consumes": [
                     "application/json"
                 ],
@@ -92,14 +97,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "gpu"
+                    "collectors"
+                ],
+                "summary": "Run a collector",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collector name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get GPU information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.GPUInfo"
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -111,9 +137,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/hardware": {
+        "/api/v1/cpu": {
             "get": {
-                "description": "Retrieve hardware information including motherboard, BIOS, and device details",
+                "description": "Retrieve detailed CPU information including core and socket counts, frequency, per-core usage, time breakdown, load averages, and context switch/interrupt rates",
                 "consumes": [
                     "application/json"
                 ],
@@ -121,14 +147,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hardware"
+                    "cpu"
+                ],
+                "summary": "Get CPU information",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get hardware information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.HardwareInfo"
+                            "$ref": "#/definitions/models.CPUInfo"
                         }
                     },
                     "500": {
@@ -140,9 +174,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/location": {
+        "/api/v1/disk": {
             "get": {
-                "description": "Retrieve system location information including timezone and locale",
+                "description": "Retrieve total, used, and free space summed across filtered partitions, counting each device once",
                 "consumes": [
                     "application/json"
                 ],
@@ -150,14 +184,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "location"
+                    "disk"
+                ],
+                "summary": "Get disk information",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get location information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LocationInfo"
+                            "$ref": "#/definitions/models.DiskInfo"
                         }
                     },
                     "500": {
@@ -169,9 +211,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/memory": {
+        "/api/v1/disk/io": {
             "get": {
-                "description": "Retrieve memory information including total, used, and available memory",
+                "description": "Retrieve read/write throughput, IOPS, average latency, and utilization per block device since the previous request (at least one second apart)",
                 "consumes": [
                     "application/json"
                 ],
@@ -179,14 +221,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "memory"
+                    "disk"
+                ],
+                "summary": "Get disk I/O statistics",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get memory information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MemoryInfo"
+                            "$ref": "#/definitions/models.DiskIO"
                         }
                     },
                     "500": {
@@ -198,9 +248,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/os": {
+        "/api/v1/disk/partitions": {
             "get": {
-                "description": "Retrieve operating system information including name, version, and architecture",
+                "description": "Retrieve device, mountpoint, fstype, mount options, space, and inode usage for each mounted filesystem that passes the configured filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,14 +258,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "os"
+                    "disk"
+                ],
+                "summary": "Get disk partitions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get OS information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.OSInfo"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DiskPartition"
+                            }
                         }
                     },
                     "500": {
@@ -227,9 +288,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/schedules": {
+        "/api/v1/gpu": {
             "get": {
-                "description": "Retrieve a list of all schedules",
+                "description": "Retrieve GPU information including model, memory, and driver details",
                 "consumes": [
                     "application/json"
                 ],
@@ -237,14 +298,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "gpu"
+                ],
+                "summary": "Get GPU information",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all schedules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
+                            "$ref": "#/definitions/models.GPUInfo"
                         }
                     },
                     "500": {
@@ -254,9 +323,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new schedule with the provided details",
+            }
+        },
+        "/api/v1/hardware": {
+            "get": {
+                "description": "Retrieve the system vendor and product, motherboard, BIOS, and chassis type from DMI/SMBIOS. Network interfaces are served by /api/v1/network.",
                 "consumes": [
                     "application/json"
                 ],
@@ -264,31 +335,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "hardware"
                 ],
-                "summary": "Add a new schedule",
+                "summary": "Get hardware information",
                 "parameters": [
                     {
-                        "description": "Schedule details",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/database.Schedule"
-                        }
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.HardwareInfo"
                         }
                     },
                     "500": {
@@ -300,9 +362,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/schedules/sync": {
-            "post": {
-                "description": "Manually trigger synchronization with the system scheduler",
+        "/api/v1/hardware/pci": {
+            "get": {
+                "description": "Retrieve the devices on the PCI bus with vendor, device, and subsystem IDs, best-effort names from the bundled subset of pci.ids, class, revision, and bound driver (Linux only). The subset lists common vendors and devices only, so names may be empty; rely on the IDs",
                 "consumes": [
                     "application/json"
                 ],
@@ -310,14 +372,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "hardware"
+                ],
+                "summary": "Get PCI devices",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Sync with system scheduler",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PCIDevice"
+                            }
                         }
                     },
                     "500": {
@@ -329,9 +402,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/schedules/{id}": {
+        "/api/v1/hardware/usb": {
             "get": {
-                "description": "Retrieve a specific schedule by ID",
+                "description": "Retrieve the devices on the USB buses, root hubs included, with vendor and product IDs, best-effort names from the bundled subset of usb.ids, class, speed, port path, and bound drivers (Linux only). The subset lists common vendors and products only, so names may be empty; rely on the IDs",
                 "consumes": [
                     "application/json"
                 ],
@@ -339,35 +412,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "hardware"
                 ],
-                "summary": "Get a schedule",
+                "summary": "Get USB devices",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.USBDevice"
+                            }
                         }
                     },
                     "500": {
@@ -377,9 +440,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing schedule by ID",
+            }
+        },
+        "/api/v1/location": {
+            "get": {
+                "description": "Retrieve system location information including timezone and locale",
                 "consumes": [
                     "application/json"
                 ],
@@ -387,44 +452,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "location"
                 ],
-                "summary": "Update a schedule",
+                "summary": "Get location information",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated schedule details",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/database.Schedule"
-                        }
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.LocationInfo"
                         }
                     },
                     "500": {
@@ -434,9 +477,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a schedule by ID",
+            }
+        },
+        "/api/v1/memory": {
+            "get": {
+                "description": "Retrieve memory information including totals, buffers/cache/shared/slab, swap usage, swap and major page fault rates, and Linux pressure stall information",
                 "consumes": [
                     "application/json"
                 ],
@@ -444,35 +489,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "memory"
                 ],
-                "summary": "Delete a schedule",
+                "summary": "Get memory information",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.MemoryInfo"
                         }
                     },
                     "500": {
@@ -484,9 +516,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/schedules/{id}/toggle": {
-            "patch": {
-                "description": "Enable or disable a schedule by ID",
+        "/api/v1/network": {
+            "get": {
+                "description": "Retrieve flags, IPv4/IPv6 addresses, MTU, link speed and duplex, traffic counters, and per-second rates for each interface that passes the configured filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -494,43 +526,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "network"
                 ],
-                "summary": "Toggle a schedule",
+                "summary": "Get network interfaces",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Enable/disable flag",
-                        "name": "enabled",
-                        "in": "body",
-                        "required": true,
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "enabled": {
-                                    "type": "boolean"
-                                }
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.NetworkInterface"
                             }
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/os": {
+            "get": {
+                "description": "Retrieve operating system information including name, version, and architecture",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "os"
+                ],
+                "summary": "Get OS information",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.OSInfo"
                         }
                     },
                     "500": {
@@ -542,9 +593,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/system": {
+        "/api/v1/power": {
             "get": {
-                "description": "Retrieve comprehensive system information including CPU, GPU, memory, disk, and hardware details",
+                "description": "Retrieve AC adapter state and per-battery charge, rate, health, cycle count, and estimated time remaining from Linux power_supply",
                 "consumes": [
                     "application/json"
                 ],
@@ -552,14 +603,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "system"
+                    "power"
+                ],
+                "summary": "Get power supply status",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all system information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SystemInfo"
+                            "$ref": "#/definitions/models.PowerStatus"
                         }
                     },
                     "500": {
@@ -571,9 +630,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/test": {
+        "/api/v1/processes": {
             "get": {
-                "description": "A flexible endpoint for testing custom methods and logic",
+                "description": "Retrieve running processes sorted by CPU, memory, I/O, or start time, optionally filtered by name and user",
                 "consumes": [
                     "application/json"
                 ],
@@ -581,14 +640,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test"
+                    "processes"
+                ],
+                "summary": "List processes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sort key: cpu, memory, io, or start (default: cpu)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: asc or desc (default: desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the process name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username of the process owner",
+                        "name": "user",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of processes to return (default: 25, max: 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Test route for personal methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
+                            "$ref": "#/definitions/models.ProcessList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -600,9 +697,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/usage": {
+        "/api/v1/processes/audit": {
             "get": {
-                "description": "Retrieve usage percentages for CPU, GPU, memory, and disk",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve attempted process actions, newest first, including refused and failed attempts",
                 "consumes": [
                     "application/json"
                 ],
@@ -610,14 +712,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "usage"
+                    "processes"
+                ],
+                "summary": "List process actions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries (default: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get usage percentages",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UsagePercentages"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/database.ProcessAudit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -629,9 +760,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/api/v1/processes/{pid}": {
             "get": {
-                "description": "Check if the API is running and healthy",
+                "description": "Retrieve command line, open file count, threads, children, and connections of a process",
                 "consumes": [
                     "application/json"
                 ],
@@ -639,526 +770,3336 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "processes"
+                ],
+                "summary": "Get process details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Process ID",
+                        "name": "pid",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.APIResponse"
+                            "$ref": "#/definitions/models.ProcessDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "database.Schedule": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "enabled": {
-                    "type": "boolean"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "repeat_pattern": {
-                    "description": "once, daily, weekly",
-                    "type": "string"
-                },
-                "start_time": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.APIResponse": {
-            "description": "Standard API response structure",
-            "type": "object",
-            "properties": {
-                "data": {},
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "System benchmark API is running"
-                },
-                "status": {
-                    "type": "string",
-                    "example": "ok"
-                }
-            }
         },
-        "models.CPU": {
-            "description": "CPU information including cores, model, cache, frequency, and usage",
-            "type": "object",
-            "properties": {
-                "cache_size": {
-                    "type": "string",
-                    "example": "8MB"
-                },
-                "cores": {
-                    "type": "integer",
-                    "example": 8
-                },
-                "cpu_usage_percentage": {
-                    "type": "string",
-                    "example": "45.2%"
-                },
-                "ghz": {
-                    "type": "string",
-                    "example": "3.2GHz"
-                },
-                "model": {
-                    "type": "string",
-                    "example": "Intel Core i7-10700K"
+        "/api/v1/processes/{pid}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Issue a single-use token, valid for one minute, that authorizes one signal or renice on one process",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "processes"
+                ],
+                "summary": "Request a confirmation token",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Process ID",
+                        "name": "pid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Action to confirm",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessConfirmationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessConfirmation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "models.CPUInfo": {
-            "description": "CPU information including cores, model, cache, frequency, and usage",
-            "type": "object",
-            "properties": {
-                "cache_size": {
+        "/api/v1/processes/{pid}/renice": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change the nice value of a process (priority class on Windows). Requires a token from the confirm endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "processes"
+                ],
+                "summary": "Renice a process",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Process ID",
+                        "name": "pid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nice value and confirmation token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessReniceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessActionResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/processes/{pid}/signal": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send SIGTERM, SIGKILL, SIGSTOP or SIGCONT to a process. Requires a token from the confirm endpoint. PID 1, kernel threads and the API itself are refused.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "processes"
+                ],
+                "summary": "Signal a process",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Process ID",
+                        "name": "pid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Signal and confirmation token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessSignalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessActionResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/processes/{pid}/wait": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Block until the process exits or the timeout elapses",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "processes"
+                ],
+                "summary": "Wait for a process to exit",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Process ID",
+                        "name": "pid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Go duration (e.g. 5s) or seconds (default: 10s, max: 1m)",
+                        "name": "timeout",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProcessWaitResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/schedules": {
+            "get": {
+                "description": "Retrieve a list of all schedules",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "List all schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new schedule with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Add a new schedule",
+                "parameters": [
+                    {
+                        "description": "Schedule details",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/database.Schedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/schedules/sync": {
+            "post": {
+                "description": "Manually trigger synchronization with the system scheduler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Sync with system scheduler",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/schedules/{id}": {
+            "get": {
+                "description": "Retrieve a specific schedule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get a schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing schedule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Update a schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated schedule details",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/database.Schedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a schedule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Delete a schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/schedules/{id}/toggle": {
+            "patch": {
+                "description": "Enable or disable a schedule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Toggle a schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Enable/disable flag",
+                        "name": "enabled",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "enabled": {
+                                    "type": "boolean"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sensors": {
+            "get": {
+                "description": "Retrieve temperatures with the high and critical thresholds reported by the kernel, plus fan speeds and voltages from Linux hwmon",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sensors"
+                ],
+                "summary": "Get sensor readings",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Sensors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/system": {
+            "get": {
+                "description": "Retrieve comprehensive system information including CPU, GPU, memory, disk, and hardware details.\nSections that fail are left out and described in errors; status is complete (200), partial (207), or failed (500).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Get all system information",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/test": {
+            "get": {
+                "description": "A flexible endpoint for testing custom methods and logic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test"
+                ],
+                "summary": "Test route for personal methods",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/usage": {
+            "get": {
+                "description": "Retrieve usage percentages for CPU, GPU, memory, and disk from the background sampler, optionally averaged over a window",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "usage"
+                ],
+                "summary": "Get usage percentages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Average over this duration, e.g. 10s or 1m (default: latest sample)",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "host, or cgroup for CPU and memory relative to the process's cgroup limits (default: host)",
+                        "name": "relative_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsagePercentages"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/usage/history": {
+            "get": {
+                "description": "Retrieve CPU, GPU, memory, and disk usage averaged into buckets over a time range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "usage"
+                ],
+                "summary": "Get usage history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Range start as RFC3339 or unix seconds (default: one hour before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end as RFC3339 or unix seconds (default: now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size as a duration (e.g. 5m) or seconds (default: sampling interval)",
+                        "name": "step",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsageHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/usage/stream": {
+            "get": {
+                "description": "Stream CPU, GPU, memory, and disk usage as Server-Sent Events (\"usage\" events). All clients share a single sampler.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "usage"
+                ],
+                "summary": "Stream usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery interval as a duration (e.g. 5s) or seconds (default and minimum: sampling interval)",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsageSample"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/collectors": {
+            "get": {
+                "description": "List every registered collector with how long its results stay fresh",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "List collectors (v2)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CollectorInfo"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/collectors/{name}": {
+            "get": {
+                "description": "Run one registered collector by name, e.g. os, network, sensors, or power, and return its v2 model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Run a collector (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collector name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/cpu": {
+            "get": {
+                "description": "Retrieve CPU topology, cache size in bytes, frequency in MHz, and usage percentages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get CPU information (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CPUV2"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/disk": {
+            "get": {
+                "description": "Retrieve total, used, and free disk space in bytes and the used percentage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get disk information (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DiskV2"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/gpu": {
+            "get": {
+                "description": "Retrieve GPUs with video memory in bytes, usage percentage, and clock in MHz",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get GPU information (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.GPUV2"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/memory": {
+            "get": {
+                "description": "Retrieve memory and swap sizes in bytes, usage percentages, paging rates, and pressure",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get memory information (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MemoryV2"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/system": {
+            "get": {
+                "description": "Same sections, status, and errors as /api/v1/system, with CPU, GPU, memory, and disk as numeric v2 models. Use ?format=human for formatted values.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get all system information (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Set to 1 to bypass cached results",
+                        "name": "fresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.SystemInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/usage": {
+            "get": {
+                "description": "Retrieve CPU, GPU, memory, and disk usage percentages and disk throughput from the background sampler, optionally averaged over a window",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v2"
+                ],
+                "summary": "Get usage (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to human to render numbers with units",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Average over this duration, e.g. 10s or 1m (default: latest sample)",
+                        "name": "window",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UsageV2"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check if the API is running and healthy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "database.ProcessAudit": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "outcome": {
+                    "description": "succeeded, failed, refused",
+                    "type": "string"
+                },
+                "performed_at": {
+                    "type": "string"
+                },
+                "pid": {
+                    "type": "integer"
+                },
+                "process_name": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "api, desktop",
+                    "type": "string"
+                }
+            }
+        },
+        "database.Schedule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "repeat_pattern": {
+                    "description": "once, daily, weekly",
+                    "type": "string"
+                },
+                "require_ac_power": {
+                    "description": "skip launching while running on battery",
+                    "type": "boolean"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.APIResponse": {
+            "description": "Standard API response structure",
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "System benchmark API is running"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "models.Battery": {
+            "type": "object",
+            "properties": {
+                "charge_percent": {
+                    "type": "number",
+                    "example": 76
+                },
+                "cycle_count": {
+                    "type": "integer",
+                    "example": 312
+                },
+                "energy_design_wh": {
+                    "type": "number",
+                    "example": 57
+                },
+                "energy_full_wh": {
+                    "type": "number",
+                    "example": 50.1
+                },
+                "energy_wh": {
+                    "type": "number",
+                    "example": 38.2
+                },
+                "health_percent": {
+                    "type": "number",
+                    "example": 87.9
+                },
+                "name": {
+                    "type": "string",
+                    "example": "BAT0"
+                },
+                "rate_watts": {
+                    "type": "number",
+                    "example": -12.4
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Discharging"
+                },
+                "time_remaining_seconds": {
+                    "type": "integer",
+                    "example": 11100
+                }
+            }
+        },
+        "models.CPUInfo": {
+            "description": "CPU information including cores, model, cache, frequency, usage, time breakdown, and load",
+            "type": "object",
+            "properties": {
+                "cache_size": {
+                    "type": "string",
+                    "example": "8MB"
+                },
+                "context_switches_per_sec": {
+                    "type": "number",
+                    "example": 15234
+                },
+                "cores": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "cpu_usage_percentage": {
+                    "type": "string",
+                    "example": "45.2%"
+                },
+                "ghz": {
+                    "type": "string",
+                    "example": "3.2GHz"
+                },
+                "interrupts_per_sec": {
+                    "type": "number",
+                    "example": 8421
+                },
+                "load_average": {
+                    "$ref": "#/definitions/models.LoadAverage"
+                },
+                "logical_cores": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "model": {
+                    "type": "string",
+                    "example": "Intel Core i7-10700K"
+                },
+                "per_core_usage": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        12.5,
+                        40.1
+                    ]
+                },
+                "physical_cores": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "sockets": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "times": {
+                    "$ref": "#/definitions/models.CPUTimePercentages"
+                }
+            }
+        },
+        "models.CPUTimePercentages": {
+            "description": "Percentage of CPU time spent in each mode over the measurement window",
+            "type": "object",
+            "properties": {
+                "idle": {
+                    "type": "number",
+                    "example": 55.1
+                },
+                "iowait": {
+                    "type": "number",
+                    "example": 2.1
+                },
+                "irq": {
+                    "type": "number",
+                    "example": 0.3
+                },
+                "nice": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "softirq": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "steal": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "system": {
+                    "type": "number",
+                    "example": 10.2
+                },
+                "user": {
+                    "type": "number",
+                    "example": 30.5
+                }
+            }
+        },
+        "models.CPUV2": {
+            "description": "CPU model, topology, cache size in bytes, frequency in MHz, and usage percentages",
+            "type": "object",
+            "properties": {
+                "cache_bytes": {
+                    "type": "integer",
+                    "example": 16777216
+                },
+                "context_switches_per_sec": {
+                    "type": "number",
+                    "example": 15234
+                },
+                "frequency_mhz": {
+                    "type": "number",
+                    "example": 3800
+                },
+                "interrupts_per_sec": {
+                    "type": "number",
+                    "example": 8421
+                },
+                "load_average": {
+                    "$ref": "#/definitions/models.LoadAverage"
+                },
+                "logical_cores": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "model": {
+                    "type": "string",
+                    "example": "Intel Core i7-10700K"
+                },
+                "per_core_usage_percent": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        12.5,
+                        40.1
+                    ]
+                },
+                "physical_cores": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "sockets": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "times": {
+                    "$ref": "#/definitions/models.CPUTimePercentages"
+                },
+                "usage_percent": {
+                    "type": "number",
+                    "example": 45.2
+                }
+            }
+        },
+        "models.Cgroup": {
+            "description": "cgroup v1 or v2 CPU, memory, and process limits with current usage (Linux only)",
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/models.CgroupCPU"
+                },
+                "memory": {
+                    "$ref": "#/definitions/models.CgroupMemory"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/docker/3f4e2a"
+                },
+                "pids": {
+                    "$ref": "#/definitions/models.CgroupPids"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.CgroupCPU": {
+            "type": "object",
+            "properties": {
+                "cpuset_cpus": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "effective_cpus": {
+                    "type": "number",
+                    "example": 2
+                },
+                "period_us": {
+                    "type": "integer",
+                    "example": 100000
+                },
+                "periods": {
+                    "type": "integer",
+                    "example": 52311
+                },
+                "quota_us": {
+                    "type": "integer",
+                    "example": 200000
+                },
+                "throttled_periods": {
+                    "type": "integer",
+                    "example": 1204
+                },
+                "throttled_seconds": {
+                    "type": "number",
+                    "example": 42.7
+                },
+                "usage_seconds": {
+                    "type": "number",
+                    "example": 1234.5
+                }
+            }
+        },
+        "models.CgroupMemory": {
+            "type": "object",
+            "properties": {
+                "limit_bytes": {
+                    "type": "integer",
+                    "example": 536870912
+                },
+                "oom_events": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "oom_kills": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "peak_bytes": {
+                    "type": "integer",
+                    "example": 314572800
+                },
+                "soft_limit_bytes": {
+                    "type": "integer",
+                    "example": 402653184
+                },
+                "usage_bytes": {
+                    "type": "integer",
+                    "example": 268435456
+                }
+            }
+        },
+        "models.CgroupPids": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 4096
+                }
+            }
+        },
+        "models.CollectorInfo": {
+            "description": "Name and freshness of a registered collector",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "cpu"
+                },
+                "ttl_seconds": {
+                    "type": "number",
+                    "example": 2
+                }
+            }
+        },
+        "models.DiskIO": {
+            "description": "Per-device disk I/O rates computed from counter deltas",
+            "type": "object",
+            "properties": {
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DiskIODevice"
+                    }
+                },
+                "interval_seconds": {
+                    "type": "number",
+                    "example": 1
+                },
+                "sampled_at": {
+                    "type": "string",
+                    "example": "2024-01-01T03:00:00Z"
+                }
+            }
+        },
+        "models.DiskIODevice": {
+            "description": "Throughput, IOPS, latency, and utilization of a block device",
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "nvme0n1"
+                },
+                "read_bytes_per_sec": {
+                    "type": "number",
+                    "example": 1048576
+                },
+                "read_iops": {
+                    "type": "number",
+                    "example": 120
+                },
+                "read_latency_ms": {
+                    "type": "number",
+                    "example": 0.4
+                },
+                "utilization_percent": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "write_bytes_per_sec": {
+                    "type": "number",
+                    "example": 524288
+                },
+                "write_iops": {
+                    "type": "number",
+                    "example": 45
+                },
+                "write_latency_ms": {
+                    "type": "number",
+                    "example": 1.2
+                }
+            }
+        },
+        "models.DiskInfo": {
+            "description": "Disk information including total, used, free, and usage percentage",
+            "type": "object",
+            "properties": {
+                "free": {
+                    "type": "string",
+                    "example": "500GB"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "1TB"
+                },
+                "used": {
+                    "type": "string",
+                    "example": "500GB"
+                },
+                "used_percentage": {
+                    "type": "string",
+                    "example": "50%"
+                }
+            }
+        },
+        "models.DiskPartition": {
+            "description": "Mounted filesystem with space and inode usage in bytes and counts",
+            "type": "object",
+            "properties": {
+                "device": {
+                    "type": "string",
+                    "example": "/dev/nvme0n1p2"
+                },
+                "free": {
+                    "type": "integer",
+                    "example": 256055095296
+                },
+                "fstype": {
+                    "type": "string",
+                    "example": "ext4"
+                },
+                "inodes_free": {
+                    "type": "integer",
+                    "example": 30212096
+                },
+                "inodes_total": {
+                    "type": "integer",
+                    "example": 31260672
+                },
+                "inodes_used": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "inodes_used_percent": {
+                    "type": "number",
+                    "example": 3.4
+                },
+                "mountpoint": {
+                    "type": "string",
+                    "example": "/"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "rw",
+                        "relatime"
+                    ]
+                },
+                "read_only": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 512110190592
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 256055095296
+                },
+                "used_percent": {
+                    "type": "number",
+                    "example": 50
+                }
+            }
+        },
+        "models.DiskV2": {
+            "description": "Disk space in bytes across all devices, counting each device once",
+            "type": "object",
+            "properties": {
+                "free_bytes": {
+                    "type": "integer",
+                    "example": 500102443008
+                },
+                "total_bytes": {
+                    "type": "integer",
+                    "example": 1000204886016
+                },
+                "used_bytes": {
+                    "type": "integer",
+                    "example": 500102443008
+                },
+                "used_percent": {
+                    "type": "number",
+                    "example": 50
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "description": "Error response structure",
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "string",
+                    "example": "connection timeout"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Failed to get system information"
+                }
+            }
+        },
+        "models.FanSensor": {
+            "type": "object",
+            "properties": {
+                "chip": {
+                    "type": "string",
+                    "example": "nct6775"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "fan1"
+                },
+                "max": {
+                    "type": "number",
+                    "example": 2500
+                },
+                "min": {
+                    "type": "number",
+                    "example": 300
+                },
+                "rpm": {
+                    "type": "number",
+                    "example": 1200
+                }
+            }
+        },
+        "models.GPUInfo": {
+            "description": "GPU information including name, VRAM, driver, usage, and clock speed",
+            "type": "object",
+            "properties": {
+                "clock_speed": {
+                    "type": "string",
+                    "example": "1800MHz"
+                },
+                "driver": {
+                    "type": "string",
+                    "example": "470.82.01"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "NVIDIA GeForce RTX 3080"
+                },
+                "pci_address": {
+                    "type": "string",
+                    "example": "0000:01:00.0"
+                },
+                "usage_percentage": {
+                    "type": "string",
+                    "example": "30%"
+                },
+                "vram_size": {
+                    "type": "string",
+                    "example": "10GB"
+                }
+            }
+        },
+        "models.GPUV2": {
+            "description": "GPU name, driver, video memory in bytes, usage percentage, and clock in MHz",
+            "type": "object",
+            "properties": {
+                "clock_mhz": {
+                    "type": "number",
+                    "example": 1800
+                },
+                "driver": {
+                    "type": "string",
+                    "example": "nvidia 535.104.05"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "NVIDIA GeForce RTX 3080"
+                },
+                "pci_address": {
+                    "type": "string",
+                    "example": "0000:01:00.0"
+                },
+                "usage_percent": {
+                    "type": "number",
+                    "example": 30
+                },
+                "vram_bytes": {
+                    "type": "integer",
+                    "example": 10737418240
+                }
+            }
+        },
+        "models.HardwareBIOS": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "03/12/2024"
+                },
+                "vendor": {
+                    "type": "string",
+                    "example": "American Megatrends Inc."
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2403"
+                }
+            }
+        },
+        "models.HardwareBoard": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "PRIME Z490-A"
+                },
+                "serial": {
+                    "type": "string",
+                    "example": "200412345678901"
+                },
+                "vendor": {
+                    "type": "string",
+                    "example": "ASUSTeK COMPUTER INC."
+                },
+                "version": {
+                    "type": "string",
+                    "example": "Rev 1.xx"
+                }
+            }
+        },
+        "models.HardwareChassis": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "example": "Desktop"
+                },
+                "vendor": {
+                    "type": "string",
+                    "example": "Dell Inc."
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.0"
+                }
+            }
+        },
+        "models.HardwareInfo": {
+            "description": "System, motherboard, BIOS, and chassis details from DMI/SMBIOS (Linux only; empty elsewhere)",
+            "type": "object",
+            "properties": {
+                "bios": {
+                    "$ref": "#/definitions/models.HardwareBIOS"
+                },
+                "board": {
+                    "$ref": "#/definitions/models.HardwareBoard"
+                },
+                "chassis": {
+                    "$ref": "#/definitions/models.HardwareChassis"
+                },
+                "system": {
+                    "$ref": "#/definitions/models.HardwareSystem"
+                }
+            }
+        },
+        "models.HardwareSystem": {
+            "type": "object",
+            "properties": {
+                "family": {
+                    "type": "string",
+                    "example": "XPS"
+                },
+                "product": {
+                    "type": "string",
+                    "example": "XPS 15 9520"
+                },
+                "serial": {
+                    "type": "string",
+                    "example": "5CG1234XYZ"
+                },
+                "vendor": {
+                    "type": "string",
+                    "example": "Dell Inc."
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.0"
+                }
+            }
+        },
+        "models.HostRoots": {
+            "type": "object",
+            "properties": {
+                "cgroup": {
+                    "type": "string",
+                    "example": "/sys/fs/cgroup"
+                },
+                "etc": {
+                    "type": "string",
+                    "example": "/host/etc"
+                },
+                "proc": {
+                    "type": "string",
+                    "example": "/host/proc"
+                },
+                "run": {
+                    "type": "string",
+                    "example": "/host/run"
+                },
+                "sys": {
+                    "type": "string",
+                    "example": "/host/sys"
+                }
+            }
+        },
+        "models.LoadAverage": {
+            "description": "Average number of runnable processes over 1, 5 and 15 minutes",
+            "type": "object",
+            "properties": {
+                "load1": {
+                    "type": "number",
+                    "example": 1.25
+                },
+                "load15": {
+                    "type": "number",
+                    "example": 0.75
+                },
+                "load5": {
+                    "type": "number",
+                    "example": 0.98
+                }
+            }
+        },
+        "models.LocationInfo": {
+            "description": "Location information including IP, hostname, city, region, country, and timezone",
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "example": "San Francisco"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "US"
+                },
+                "hostname": {
+                    "type": "string",
+                    "example": "my-server"
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "192.168.1.100"
+                },
+                "loc": {
+                    "type": "string",
+                    "example": "37.7749,-122.4194"
+                },
+                "org": {
+                    "type": "string",
+                    "example": "AS1234 Example Corp"
+                },
+                "postal": {
+                    "type": "string",
+                    "example": "94105"
+                },
+                "readme": {
+                    "type": "string",
+                    "example": "https://ipinfo.io/missingauth"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "California"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Los_Angeles"
+                }
+            }
+        },
+        "models.MemoryInfo": {
+            "description": "Memory information including total, used, free, available, usage percentage, swap, paging rates, and pressure",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "string",
+                    "example": "12GB"
+                },
+                "buffers": {
+                    "type": "string",
+                    "example": "268.4 MB"
+                },
+                "cached": {
+                    "type": "string",
+                    "example": "4.3 GB"
+                },
+                "free": {
+                    "type": "string",
+                    "example": "4GB"
+                },
+                "major_faults": {
+                    "type": "string",
+                    "example": "12.0/s"
+                },
+                "pressure": {
+                    "$ref": "#/definitions/models.PressureStall"
+                },
+                "shared": {
+                    "type": "string",
+                    "example": "536.9 MB"
+                },
+                "slab": {
+                    "type": "string",
+                    "example": "402.7 MB"
+                },
+                "swap_in": {
+                    "type": "string",
+                    "example": "41.0 KB/s"
+                },
+                "swap_out": {
+                    "type": "string",
+                    "example": "81.9 KB/s"
+                },
+                "swap_total": {
+                    "type": "string",
+                    "example": "8.6 GB"
+                },
+                "swap_used": {
+                    "type": "string",
+                    "example": "1.1 GB"
+                },
+                "swap_used_percentage": {
+                    "type": "string",
+                    "example": "12.5%"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "16GB"
+                },
+                "used": {
+                    "type": "string",
+                    "example": "8GB"
+                },
+                "used_percentage": {
+                    "type": "string",
+                    "example": "50%"
+                }
+            }
+        },
+        "models.MemoryV2": {
+            "description": "Memory and swap sizes in bytes, usage percentages, paging rates, and pressure",
+            "type": "object",
+            "properties": {
+                "available_bytes": {
+                    "type": "integer",
+                    "example": 12884901888
+                },
+                "buffers_bytes": {
+                    "type": "integer",
+                    "example": 268435456
+                },
+                "cached_bytes": {
+                    "type": "integer",
+                    "example": 4294967296
+                },
+                "free_bytes": {
+                    "type": "integer",
+                    "example": 4294967296
+                },
+                "major_faults_per_sec": {
+                    "type": "number",
+                    "example": 12
+                },
+                "pressure": {
+                    "$ref": "#/definitions/models.SystemPressure"
+                },
+                "shared_bytes": {
+                    "type": "integer",
+                    "example": 536870912
+                },
+                "slab_bytes": {
+                    "type": "integer",
+                    "example": 402653184
+                },
+                "swap_in_bytes_per_sec": {
+                    "type": "number",
+                    "example": 40960
+                },
+                "swap_out_bytes_per_sec": {
+                    "type": "number",
+                    "example": 81920
+                },
+                "swap_total_bytes": {
+                    "type": "integer",
+                    "example": 8589934592
+                },
+                "swap_used_bytes": {
+                    "type": "integer",
+                    "example": 1073741824
+                },
+                "swap_used_percent": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "total_bytes": {
+                    "type": "integer",
+                    "example": 17179869184
+                },
+                "used_bytes": {
+                    "type": "integer",
+                    "example": 8589934592
+                },
+                "used_percent": {
+                    "type": "number",
+                    "example": 50
+                }
+            }
+        },
+        "models.NetworkAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "192.168.1.10"
+                },
+                "family": {
+                    "type": "string",
+                    "example": "ipv4"
+                },
+                "prefix": {
+                    "type": "integer",
+                    "example": 24
+                }
+            }
+        },
+        "models.NetworkInterface": {
+            "description": "Network interface flags, addresses, link speed, cumulative counters, and per-second rates",
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NetworkAddress"
+                    }
+                },
+                "counters": {
+                    "$ref": "#/definitions/models.NetworkTraffic"
+                },
+                "duplex": {
+                    "type": "string",
+                    "example": "full"
+                },
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "up",
+                        "broadcast",
+                        "multicast"
+                    ]
+                },
+                "hardware_addr": {
+                    "type": "string",
+                    "example": "00:11:22:33:44:55"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "mtu": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "name": {
+                    "type": "string",
+                    "example": "eth0"
+                },
+                "rates": {
+                    "$ref": "#/definitions/models.NetworkRates"
+                },
+                "speed_mbps": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "models.NetworkRates": {
+            "type": "object",
+            "properties": {
+                "bytes_recv": {
+                    "type": "number",
+                    "example": 1048576
+                },
+                "bytes_sent": {
+                    "type": "number",
+                    "example": 131072
+                },
+                "drops_in": {
+                    "type": "number",
+                    "example": 0
+                },
+                "drops_out": {
+                    "type": "number",
+                    "example": 0
+                },
+                "errors_in": {
+                    "type": "number",
+                    "example": 0
+                },
+                "errors_out": {
+                    "type": "number",
+                    "example": 0
+                },
+                "packets_recv": {
+                    "type": "number",
+                    "example": 850
+                },
+                "packets_sent": {
+                    "type": "number",
+                    "example": 120
+                }
+            }
+        },
+        "models.NetworkTraffic": {
+            "type": "object",
+            "properties": {
+                "bytes_recv": {
+                    "type": "integer",
+                    "example": 987654321
+                },
+                "bytes_sent": {
+                    "type": "integer",
+                    "example": 123456789
+                },
+                "drops_in": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "drops_out": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "errors_in": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "errors_out": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "packets_recv": {
+                    "type": "integer",
+                    "example": 654321
+                },
+                "packets_sent": {
+                    "type": "integer",
+                    "example": 123456
+                }
+            }
+        },
+        "models.OSInfo": {
+            "description": "Operating system information including name, hostname, platform, version, uptime, virtualization, sessions, timezone, and locale",
+            "type": "object",
+            "properties": {
+                "boot_time": {
+                    "type": "string",
+                    "example": "2024-01-01T03:00:00Z"
+                },
+                "container_runtime": {
+                    "type": "string",
+                    "example": "docker"
+                },
+                "containerized": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "host_id": {
+                    "type": "string",
+                    "example": "8f0c6f5e-1c2d-4a3b-9e8f-7a6b5c4d3e2f"
+                },
+                "host_roots": {
+                    "$ref": "#/definitions/models.HostRoots"
+                },
+                "hostname": {
+                    "type": "string",
+                    "example": "my-server"
+                },
+                "init_system": {
+                    "type": "string",
+                    "example": "systemd"
+                },
+                "kernel_arch": {
                     "type": "string",
-                    "example": "8MB"
+                    "example": "x86_64"
                 },
-                "cores": {
+                "kernel_version": {
+                    "type": "string",
+                    "example": "5.4.0-74-generic"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en_US.UTF-8"
+                },
+                "os": {
+                    "type": "string",
+                    "example": "linux"
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "ubuntu"
+                },
+                "platform_family": {
+                    "type": "string",
+                    "example": "debian"
+                },
+                "platform_version": {
+                    "type": "string",
+                    "example": "20.04.3 LTS"
+                },
+                "process_count": {
                     "type": "integer",
-                    "example": 8
+                    "example": 312
                 },
-                "cpu_usage_percentage": {
+                "timezone": {
                     "type": "string",
-                    "example": "45.2%"
+                    "example": "Europe/Berlin"
                 },
-                "ghz": {
+                "uptime": {
+                    "type": "integer",
+                    "example": 86400
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SessionUser"
+                    }
+                },
+                "utc_offset_seconds": {
+                    "type": "integer",
+                    "example": 7200
+                },
+                "virtualization_role": {
                     "type": "string",
-                    "example": "3.2GHz"
+                    "example": "guest"
                 },
-                "model": {
+                "virtualization_system": {
                     "type": "string",
-                    "example": "Intel Core i7-10700K"
+                    "example": "kvm"
                 }
             }
         },
-        "models.Disk": {
-            "description": "Disk information including total, used, free, and usage percentage",
+        "models.PCIDevice": {
+            "description": "PCI device identity, class, and the kernel driver bound to it",
             "type": "object",
             "properties": {
-                "free": {
+                "address": {
                     "type": "string",
-                    "example": "500GB"
+                    "example": "0000:01:00.0"
                 },
-                "total": {
+                "class": {
                     "type": "string",
-                    "example": "1TB"
+                    "example": "030000"
                 },
-                "used": {
+                "class_name": {
                     "type": "string",
-                    "example": "500GB"
+                    "example": "VGA compatible controller"
                 },
-                "used_percentage": {
+                "device": {
                     "type": "string",
-                    "example": "50%"
+                    "example": "GA102 [GeForce RTX 3080]"
+                },
+                "device_id": {
+                    "type": "string",
+                    "example": "2206"
+                },
+                "driver": {
+                    "type": "string",
+                    "example": "nvidia"
+                },
+                "revision": {
+                    "type": "string",
+                    "example": "a1"
+                },
+                "subsystem_device_id": {
+                    "type": "string",
+                    "example": "403f"
+                },
+                "subsystem_vendor_id": {
+                    "type": "string",
+                    "example": "1458"
+                },
+                "vendor": {
+                    "type": "string",
+                    "example": "NVIDIA Corporation"
+                },
+                "vendor_id": {
+                    "type": "string",
+                    "example": "10de"
                 }
             }
         },
-        "models.DiskInfo": {
-            "description": "Disk information including total, used, free, and usage percentage",
+        "models.PowerStatus": {
+            "description": "AC adapter state and system batteries (Linux power_supply)",
             "type": "object",
             "properties": {
-                "free": {
+                "ac_online": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "batteries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Battery"
+                    }
+                },
+                "on_battery": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "models.PressureAverages": {
+            "type": "object",
+            "properties": {
+                "avg10": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "avg300": {
+                    "type": "number",
+                    "example": 0.2
+                },
+                "avg60": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "total_us": {
+                    "type": "integer",
+                    "example": 123456789
+                }
+            }
+        },
+        "models.PressureStall": {
+            "description": "Share of time tasks were stalled waiting for CPU, memory, or I/O",
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/models.ResourceStall"
+                },
+                "io": {
+                    "$ref": "#/definitions/models.ResourceStall"
+                },
+                "memory": {
+                    "$ref": "#/definitions/models.ResourceStall"
+                }
+            }
+        },
+        "models.ProcessActionResult": {
+            "description": "Process action outcome",
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string",
-                    "example": "500GB"
+                    "example": "SIGTERM"
                 },
-                "total": {
+                "name": {
                     "type": "string",
-                    "example": "1TB"
+                    "example": "chrome"
                 },
-                "used": {
+                "performed_at": {
                     "type": "string",
-                    "example": "500GB"
+                    "example": "2024-01-01T12:00:30Z"
                 },
-                "used_percentage": {
+                "pid": {
+                    "type": "integer",
+                    "example": 1234
+                }
+            }
+        },
+        "models.ProcessConfirmation": {
+            "description": "Confirmation token bound to a process, action and nice value",
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string",
-                    "example": "50%"
+                    "example": "SIGTERM"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:01:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "chrome"
+                },
+                "nice": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "pid": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015"
                 }
             }
         },
-        "models.ErrorResponse": {
-            "description": "Error response structure",
+        "models.ProcessConfirmationRequest": {
             "type": "object",
+            "required": [
+                "action"
+            ],
             "properties": {
-                "details": {
+                "action": {
                     "type": "string",
-                    "example": "connection timeout"
+                    "example": "SIGTERM"
                 },
-                "error": {
+                "nice": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "models.ProcessConnection": {
+            "description": "Network connection endpoint and state",
+            "type": "object",
+            "properties": {
+                "local_addr": {
                     "type": "string",
-                    "example": "Failed to get system information"
+                    "example": "127.0.0.1:7000"
+                },
+                "remote_addr": {
+                    "type": "string",
+                    "example": "10.0.0.5:51234"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ESTABLISHED"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "tcp"
                 }
             }
         },
-        "models.GPU": {
-            "description": "GPU information including name, VRAM, driver, usage, and clock speed",
+        "models.ProcessDetail": {
+            "description": "Detailed process information including command line, open files, children, and connections",
             "type": "object",
             "properties": {
-                "clock_speed": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1235,
+                        1236
+                    ]
+                },
+                "cmdline": {
                     "type": "string",
-                    "example": "1800MHz"
+                    "example": "/usr/bin/chrome --type=renderer"
                 },
-                "driver": {
+                "connections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProcessConnection"
+                    }
+                },
+                "cpu_percent": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "cwd": {
                     "type": "string",
-                    "example": "470.82.01"
+                    "example": "/home/alice"
+                },
+                "exe": {
+                    "type": "string",
+                    "example": "/usr/bin/chrome"
+                },
+                "io_read_bytes": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "io_write_bytes": {
+                    "type": "integer",
+                    "example": 2097152
+                },
+                "memory_percent": {
+                    "type": "number",
+                    "example": 3.2
+                },
+                "memory_rss": {
+                    "type": "integer",
+                    "example": 524288000
                 },
                 "name": {
                     "type": "string",
-                    "example": "NVIDIA GeForce RTX 3080"
+                    "example": "chrome"
+                },
+                "nice": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "num_threads": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "open_files": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "pid": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "ppid": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "2024-01-01T03:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "running"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "models.ProcessList": {
+            "description": "Processes matching the query, sorted and limited",
+            "type": "object",
+            "properties": {
+                "processes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProcessSummary"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 312
+                }
+            }
+        },
+        "models.ProcessReniceRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "nice": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015"
+                }
+            }
+        },
+        "models.ProcessSignalRequest": {
+            "type": "object",
+            "required": [
+                "signal",
+                "token"
+            ],
+            "properties": {
+                "signal": {
+                    "type": "string",
+                    "example": "SIGTERM"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015"
+                }
+            }
+        },
+        "models.ProcessSummary": {
+            "description": "Process identity and resource usage",
+            "type": "object",
+            "properties": {
+                "cpu_percent": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "io_read_bytes": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "io_write_bytes": {
+                    "type": "integer",
+                    "example": 2097152
+                },
+                "memory_percent": {
+                    "type": "number",
+                    "example": 3.2
+                },
+                "memory_rss": {
+                    "type": "integer",
+                    "example": 524288000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "chrome"
+                },
+                "num_threads": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "pid": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "ppid": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "2024-01-01T03:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "running"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "models.ProcessWaitResult": {
+            "description": "Whether the process exited before the timeout",
+            "type": "object",
+            "properties": {
+                "exited": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pid": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "waited_ms": {
+                    "type": "integer",
+                    "example": 350
+                }
+            }
+        },
+        "models.ResourcePressure": {
+            "type": "object",
+            "properties": {
+                "full": {
+                    "$ref": "#/definitions/models.PressureAverages"
                 },
-                "usage_percentage": {
-                    "type": "string",
-                    "example": "30%"
+                "some": {
+                    "$ref": "#/definitions/models.PressureAverages"
+                }
+            }
+        },
+        "models.ResourceStall": {
+            "type": "object",
+            "properties": {
+                "full": {
+                    "$ref": "#/definitions/models.StallAverages"
                 },
-                "vram_size": {
-                    "type": "string",
-                    "example": "10GB"
+                "some": {
+                    "$ref": "#/definitions/models.StallAverages"
                 }
             }
         },
-        "models.GPUInfo": {
-            "description": "GPU information including name, VRAM, driver, usage, and clock speed",
+        "models.SectionError": {
+            "description": "Error of a single system information section",
             "type": "object",
             "properties": {
-                "clock_speed": {
+                "code": {
                     "type": "string",
-                    "example": "1800MHz"
+                    "example": "network_error"
                 },
-                "driver": {
-                    "type": "string",
-                    "example": "470.82.01"
+                "duration_ms": {
+                    "type": "number",
+                    "example": 5012.4
                 },
-                "name": {
+                "message": {
                     "type": "string",
-                    "example": "NVIDIA GeForce RTX 3080"
+                    "example": "failed to make request: dial tcp: lookup ipinfo.io: no such host"
+                }
+            }
+        },
+        "models.Sensors": {
+            "description": "Hardware sensor readings; lists are empty when the platform exposes no sensors",
+            "type": "object",
+            "properties": {
+                "fans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FanSensor"
+                    }
                 },
-                "usage_percentage": {
-                    "type": "string",
-                    "example": "30%"
+                "temperatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TemperatureSensor"
+                    }
                 },
-                "vram_size": {
-                    "type": "string",
-                    "example": "10GB"
+                "voltages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VoltageSensor"
+                    }
                 }
             }
         },
-        "models.HardwareInfo": {
-            "description": "Hardware/network interface information including index, MTU, name, hardware address, and flags",
+        "models.SessionUser": {
             "type": "object",
             "properties": {
-                "flags": {
+                "host": {
                     "type": "string",
-                    "example": "up,broadcast,running,multicast"
+                    "example": "192.168.1.20"
                 },
-                "hardware_addr": {
+                "started": {
                     "type": "string",
-                    "example": "00:11:22:33:44:55"
-                },
-                "index": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "2024-01-01T08:00:00Z"
                 },
-                "mtu": {
-                    "type": "integer",
-                    "example": 1500
+                "terminal": {
+                    "type": "string",
+                    "example": "pts/0"
                 },
-                "name": {
+                "user": {
                     "type": "string",
-                    "example": "eth0"
+                    "example": "alice"
                 }
             }
         },
-        "models.Location": {
-            "description": "Location information including IP, hostname, city, region, country, and timezone",
+        "models.StallAverages": {
             "type": "object",
             "properties": {
-                "city": {
+                "avg10": {
                     "type": "string",
-                    "example": "San Francisco"
+                    "example": "1.50%"
                 },
-                "country": {
+                "avg300": {
                     "type": "string",
-                    "example": "US"
+                    "example": "0.20%"
                 },
-                "hostname": {
+                "avg60": {
                     "type": "string",
-                    "example": "my-server"
+                    "example": "0.80%"
                 },
-                "ip": {
+                "total": {
                     "type": "string",
-                    "example": "192.168.1.100"
+                    "example": "2m3.456789s"
+                }
+            }
+        },
+        "models.SystemInfo": {
+            "description": "Complete system information: every section that succeeded at the top level (cpu, gpus, os, memory, ...), plus status and per-section errors",
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.SectionError"
+                    }
                 },
-                "loc": {
+                "status": {
                     "type": "string",
-                    "example": "37.7749,-122.4194"
+                    "example": "partial"
+                }
+            }
+        },
+        "models.SystemPressure": {
+            "description": "Share of time tasks were stalled waiting for CPU, memory, or I/O",
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/models.ResourcePressure"
                 },
-                "org": {
-                    "type": "string",
-                    "example": "AS1234 Example Corp"
+                "io": {
+                    "$ref": "#/definitions/models.ResourcePressure"
                 },
-                "postal": {
-                    "type": "string",
-                    "example": "94105"
+                "memory": {
+                    "$ref": "#/definitions/models.ResourcePressure"
+                }
+            }
+        },
+        "models.TemperatureSensor": {
+            "type": "object",
+            "properties": {
+                "celsius": {
+                    "type": "number",
+                    "example": 54
                 },
-                "readme": {
-                    "type": "string",
-                    "example": "https://ipinfo.io/missingauth"
+                "critical": {
+                    "type": "number",
+                    "example": 110
                 },
-                "region": {
-                    "type": "string",
-                    "example": "California"
+                "high": {
+                    "type": "number",
+                    "example": 100
                 },
-                "timezone": {
+                "key": {
                     "type": "string",
-                    "example": "America/Los_Angeles"
+                    "example": "coretemp_core_0"
                 }
             }
         },
-        "models.LocationInfo": {
-            "description": "Location information including IP, hostname, city, region, country, and timezone",
+        "models.USBDevice": {
+            "description": "USB device identity, class, speed, port path, and the kernel drivers bound to its interfaces",
             "type": "object",
             "properties": {
-                "city": {
-                    "type": "string",
-                    "example": "San Francisco"
+                "bus": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "country": {
+                "class": {
                     "type": "string",
-                    "example": "US"
+                    "example": "03"
                 },
-                "hostname": {
+                "class_name": {
                     "type": "string",
-                    "example": "my-server"
+                    "example": "Human Interface Device"
                 },
-                "ip": {
+                "device_number": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "drivers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "usbhid"
+                    ]
+                },
+                "port": {
                     "type": "string",
-                    "example": "192.168.1.100"
+                    "example": "1-2.1"
                 },
-                "loc": {
+                "product": {
                     "type": "string",
-                    "example": "37.7749,-122.4194"
+                    "example": "Unifying Receiver"
                 },
-                "org": {
+                "product_id": {
                     "type": "string",
-                    "example": "AS1234 Example Corp"
+                    "example": "c52b"
                 },
-                "postal": {
+                "serial": {
                     "type": "string",
-                    "example": "94105"
+                    "example": "0123456789AB"
                 },
-                "readme": {
+                "speed_mbps": {
+                    "type": "number",
+                    "example": 12
+                },
+                "usb_version": {
                     "type": "string",
-                    "example": "https://ipinfo.io/missingauth"
+                    "example": "2.00"
                 },
-                "region": {
+                "vendor": {
                     "type": "string",
-                    "example": "California"
+                    "example": "Logitech, Inc."
                 },
-                "timezone": {
+                "vendor_id": {
                     "type": "string",
-                    "example": "America/Los_Angeles"
+                    "example": "046d"
                 }
             }
         },
-        "models.Memory": {
-            "description": "Memory information including total, used, free, available, and usage percentage",
+        "models.UsageHistory": {
+            "description": "Usage history averaged into fixed-size time buckets",
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "string",
-                    "example": "12GB"
-                },
-                "free": {
+                "from": {
                     "type": "string",
-                    "example": "4GB"
+                    "example": "2024-01-01T00:00:00Z"
                 },
-                "total": {
-                    "type": "string",
-                    "example": "16GB"
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UsagePoint"
+                    }
                 },
-                "used": {
-                    "type": "string",
-                    "example": "8GB"
+                "step": {
+                    "type": "integer",
+                    "example": 300
                 },
-                "used_percentage": {
+                "to": {
                     "type": "string",
-                    "example": "50%"
+                    "example": "2024-01-01T06:00:00Z"
                 }
             }
         },
-        "models.MemoryInfo": {
-            "description": "Memory information including total, used, free, available, and usage percentage",
+        "models.UsagePercentages": {
+            "description": "Usage percentages for CPU, GPU, memory, and disk",
             "type": "object",
             "properties": {
-                "available": {
+                "cpu_usage": {
                     "type": "string",
-                    "example": "12GB"
+                    "example": "45.2%"
                 },
-                "free": {
+                "disk_usage": {
                     "type": "string",
-                    "example": "4GB"
+                    "example": "75%"
                 },
-                "total": {
+                "gpu_usage": {
                     "type": "string",
-                    "example": "16GB"
+                    "example": "30%"
                 },
-                "used": {
+                "memory_usage": {
                     "type": "string",
-                    "example": "8GB"
+                    "example": "50%"
                 },
-                "used_percentage": {
+                "relative_to": {
                     "type": "string",
-                    "example": "50%"
+                    "example": "host"
                 }
             }
         },
-        "models.OS": {
-            "description": "Operating system information including name, hostname, platform, version, and uptime",
+        "models.UsagePoint": {
+            "description": "Averaged usage percentages over one history bucket",
             "type": "object",
             "properties": {
-                "hostname": {
-                    "type": "string",
-                    "example": "my-server"
-                },
-                "kernel_arch": {
-                    "type": "string",
-                    "example": "x86_64"
+                "cpu": {
+                    "type": "number",
+                    "example": 45.2
                 },
-                "kernel_version": {
-                    "type": "string",
-                    "example": "5.4.0-74-generic"
+                "disk": {
+                    "type": "number",
+                    "example": 75
                 },
-                "os": {
-                    "type": "string",
-                    "example": "linux"
+                "disk_read_bps": {
+                    "type": "number",
+                    "example": 1048576
                 },
-                "platform": {
-                    "type": "string",
-                    "example": "ubuntu"
+                "disk_write_bps": {
+                    "type": "number",
+                    "example": 524288
                 },
-                "platform_family": {
-                    "type": "string",
-                    "example": "debian"
+                "gpu": {
+                    "type": "number",
+                    "example": 30
                 },
-                "platform_version": {
-                    "type": "string",
-                    "example": "20.04.3 LTS"
+                "memory": {
+                    "type": "number",
+                    "example": 50
                 },
-                "uptime": {
+                "samples": {
                     "type": "integer",
-                    "example": 86400
+                    "example": 5
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2024-01-01T03:00:00Z"
                 }
             }
         },
-        "models.OSInfo": {
-            "description": "Operating system information including name, hostname, platform, version, and uptime",
+        "models.UsageSample": {
+            "description": "Numeric usage percentages for CPU, GPU, memory, and disk at a point in time",
             "type": "object",
             "properties": {
-                "hostname": {
-                    "type": "string",
-                    "example": "my-server"
+                "cpu": {
+                    "type": "number",
+                    "example": 45.2
                 },
-                "kernel_arch": {
-                    "type": "string",
-                    "example": "x86_64"
+                "disk": {
+                    "type": "number",
+                    "example": 75
                 },
-                "kernel_version": {
-                    "type": "string",
-                    "example": "5.4.0-74-generic"
+                "disk_read_bps": {
+                    "type": "number",
+                    "example": 1048576
                 },
-                "os": {
-                    "type": "string",
-                    "example": "linux"
+                "disk_write_bps": {
+                    "type": "number",
+                    "example": 524288
                 },
-                "platform": {
-                    "type": "string",
-                    "example": "ubuntu"
+                "gpu": {
+                    "type": "number",
+                    "example": 30
                 },
-                "platform_family": {
-                    "type": "string",
-                    "example": "debian"
+                "memory": {
+                    "type": "number",
+                    "example": 50
                 },
-                "platform_version": {
+                "timestamp": {
                     "type": "string",
-                    "example": "20.04.3 LTS"
+                    "example": "2024-01-01T03:00:00Z"
                 },
-                "uptime": {
-                    "type": "integer",
-                    "example": 86400
+                "window_seconds": {
+                    "type": "number",
+                    "example": 1
                 }
             }
         },
-        "models.SystemInfo": {
-            "description": "Complete system information response containing all system details",
+        "models.UsageV2": {
+            "description": "Current CPU, GPU, memory, and disk usage percentages and disk throughput",
             "type": "object",
             "properties": {
-                "cpu": {
-                    "$ref": "#/definitions/models.CPU"
+                "cpu_percent": {
+                    "type": "number",
+                    "example": 45.2
                 },
-                "disk": {
-                    "$ref": "#/definitions/models.Disk"
+                "disk_percent": {
+                    "type": "number",
+                    "example": 75
                 },
-                "gpus": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.GPU"
-                    }
+                "disk_read_bytes_per_sec": {
+                    "type": "number",
+                    "example": 1048576
                 },
-                "hardware": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.HardwareInfo"
-                    }
+                "disk_write_bytes_per_sec": {
+                    "type": "number",
+                    "example": 524288
                 },
-                "location": {
-                    "$ref": "#/definitions/models.Location"
+                "gpu_percent": {
+                    "type": "number",
+                    "example": 30
                 },
-                "memory": {
-                    "$ref": "#/definitions/models.Memory"
+                "memory_percent": {
+                    "type": "number",
+                    "example": 50
                 },
-                "os": {
-                    "$ref": "#/definitions/models.OS"
+                "window_seconds": {
+                    "type": "number",
+                    "example": 1
                 }
             }
         },
-        "models.UsagePercentages": {
-            "description": "Usage percentages for CPU, GPU, memory, and disk",
+        "models.VoltageSensor": {
             "type": "object",
             "properties": {
-                "cpu_usage": {
+                "chip": {
                     "type": "string",
-                    "example": "45.2%"
+                    "example": "nct6775"
                 },
-                "disk_usage": {
-                    "type": "string",
-                    "example": "75%"
+                "critical": {
+                    "type": "number",
+                    "example": 1.6
                 },
-                "gpu_usage": {
+                "label": {
                     "type": "string",
-                    "example": "30%"
+                    "example": "Vcore"
                 },
-                "memory_usage": {
-                    "type": "string",
-                    "example": "50%"
+                "max": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "min": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "volts": {
+                    "type": "number",
+                    "example": 1.2
                 }
             }
         }
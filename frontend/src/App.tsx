@@ -11,6 +11,9 @@ import {
   getDiskInfo, 
   getLocationInfo, 
   getHardwareInfo,
+  getNetworkInterfaces,
+  getPCIDevices,
+  getUSBDevices,
   getUsagePercentages
 } from "./services/systemService";
 import { AllSystemData } from "./types/system";
@@ -29,6 +32,9 @@ function App() {
     memory: null,
     disk: null,
     hardware: null,
+    network: null,
+    pci: null,
+    usb: null,
     usagePercentages: null
   });
   const [loading, setLoading] = useState(true);
@@ -36,7 +42,7 @@ function App() {
 
   const fetchAllSystemData = async () => {
     try {
-      const [cpu, gpuData, os, memory, disk, location, hardware, usagePercentages] = await Promise.all([
+      const [cpu, gpuData, os, memory, disk, location, hardware, network, pci, usb, usagePercentages] = await Promise.all([
         getCPUInfo().catch(err => { console.error("CPU Error:", err); return null; }),
         getGPUInfo().catch(err => { console.error("GPU Error:", err); return null; }),
         getOSInfo().catch(err => { console.error("OS Error:", err); return null; }),
@@ -44,6 +50,9 @@ function App() {
         getDiskInfo().catch(err => { console.error("Disk Error:", err); return null; }),
         getLocationInfo().catch(err => { console.error("Location Error:", err); return null; }),
         getHardwareInfo().catch(err => { console.error("Hardware Error:", err); return null; }),
+        getNetworkInterfaces().catch(err => { console.error("Network Error:", err); return null; }),
+        getPCIDevices().catch(err => { console.error("PCI Error:", err); return null; }),
+        getUSBDevices().catch(err => { console.error("USB Error:", err); return null; }),
         getUsagePercentages().catch(err => { console.error("Usage Error:", err); return null; })
       ]);
 
@@ -51,7 +60,7 @@ function App() {
       const gpus = Array.isArray(gpuData) ? gpuData : null;
       const gpu = gpus && gpus.length > 0 ? gpus[0] : null;
 
-      setSystemData({ cpu, gpu, gpus, os, location, memory, disk, hardware, usagePercentages });
+      setSystemData({ cpu, gpu, gpus, os, location, memory, disk, hardware, network, pci, usb, usagePercentages });
     } catch (err) {
       console.error("Error fetching system data:", err);
       throw err;
@@ -108,8 +117,8 @@ function App() {
 
   const refreshHardware = async () => {
     try {
-      const hardware = await getHardwareInfo();
-      setSystemData(prev => ({ ...prev, hardware }));
+      const [hardware, network, pci, usb] = await Promise.all([getHardwareInfo(), getNetworkInterfaces(), getPCIDevices(), getUSBDevices()]);
+      setSystemData(prev => ({ ...prev, hardware, network, pci, usb }));
     } catch (err) {
       console.error("Error refreshing Hardware:", err);
     }
@@ -1,5 +1,6 @@
-import { useState } from "react";
-import { AllSystemData } from "../types/system";
+import { useEffect, useState } from "react";
+import { AllSystemData, UsageSample } from "../types/system";
+import { subscribeLiveUsage } from "../services/systemService";
 
 interface DashboardProps {
   systemData: AllSystemData;
@@ -103,9 +104,17 @@ export default function Dashboard({
   onRefreshSystem, 
   onRefreshHardware 
 }: DashboardProps) {
-  const { cpu, gpu, gpus, memory, disk, os, hardware } = systemData;
+  const { cpu, gpu, gpus, memory, disk, os, hardware, network, pci, usb } = systemData;
   const [activeTab, setActiveTab] = useState('overview');
   const [refreshing, setRefreshing] = useState<string | null>(null);
+  const [liveUsage, setLiveUsage] = useState<UsageSample | null>(null);
+
+  // Receive live usage only while the dashboard is mounted
+  useEffect(() => {
+    return subscribeLiveUsage(0, setLiveUsage, alert => {
+      console.warn(`${alert.metric} usage at ${alert.value.toFixed(1)}% (threshold ${alert.threshold}%)`);
+    });
+  }, []);
 
   const handleRefresh = async () => {
     setRefreshing('all');
@@ -185,11 +194,11 @@ export default function Dashboard({
     { id: 'hardware', label: 'Hardware' }
   ];
 
-  // Extract usage percentages
-  const cpuUsage = cpu?.cpu_usage_percentage ? parseFloat(cpu.cpu_usage_percentage.replace('%', '')) : 0;
-  const gpuUsage = gpu?.usage_percentage ? parseFloat(gpu.usage_percentage.replace('%', '')) : 0;
-  const memoryUsage = memory?.used_percentage ? parseFloat(memory.used_percentage.replace('%', '')) : 0;
-  const diskUsage = disk?.used_percentage ? parseFloat(disk.used_percentage.replace('%', '')) : 0;
+  // Extract usage percentages, preferring live values when available
+  const cpuUsage = liveUsage?.cpu ?? (cpu?.cpu_usage_percentage ? parseFloat(cpu.cpu_usage_percentage.replace('%', '')) : 0);
+  const gpuUsage = liveUsage?.gpu ?? (gpu?.usage_percentage ? parseFloat(gpu.usage_percentage.replace('%', '')) : 0);
+  const memoryUsage = liveUsage?.memory ?? (memory?.used_percentage ? parseFloat(memory.used_percentage.replace('%', '')) : 0);
+  const diskUsage = liveUsage?.disk ?? (disk?.used_percentage ? parseFloat(disk.used_percentage.replace('%', '')) : 0);
 
   return (
     <div className="min-h-screen bg-gray-900">
@@ -551,7 +560,7 @@ export default function Dashboard({
           {activeTab === 'hardware' && (
             <div className="bg-gray-800 rounded-xl p-4 sm:p-6 border border-gray-700">
               <div className="flex flex-col sm:flex-row justify-between items-start sm:items-center mb-4 sm:mb-6 gap-3 sm:gap-0">
-                <h3 className="text-xl sm:text-2xl font-bold text-white">Network Interfaces</h3>
+                <h3 className="text-xl sm:text-2xl font-bold text-white">Hardware Information</h3>
                 <button
                   onClick={handleRefreshHardware}
                   disabled={refreshing === 'hardware'}
@@ -567,9 +576,33 @@ export default function Dashboard({
                   {refreshing === 'hardware' ? 'Refreshing...' : 'Refresh Hardware'}
                 </button>
               </div>
-              {hardware && hardware.length > 0 ? (
+              {hardware && (hardware.system.vendor || hardware.board.name || hardware.bios.vendor) && (
+                <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-4 gap-3 sm:gap-4 mb-6">
+                  <div className="bg-gray-700 rounded-lg p-4">
+                    <p className="text-sm text-gray-400 mb-1">System</p>
+                    <p className="font-semibold text-white break-words">{[hardware.system.vendor, hardware.system.product].filter(Boolean).join(' ') || 'Unknown'}</p>
+                    {hardware.system.serial && <p className="text-xs text-gray-400 mt-1 break-all">S/N {hardware.system.serial}</p>}
+                  </div>
+                  <div className="bg-gray-700 rounded-lg p-4">
+                    <p className="text-sm text-gray-400 mb-1">Motherboard</p>
+                    <p className="font-semibold text-white break-words">{[hardware.board.vendor, hardware.board.name].filter(Boolean).join(' ') || 'Unknown'}</p>
+                    {hardware.board.version && <p className="text-xs text-gray-400 mt-1 break-words">{hardware.board.version}</p>}
+                  </div>
+                  <div className="bg-gray-700 rounded-lg p-4">
+                    <p className="text-sm text-gray-400 mb-1">BIOS</p>
+                    <p className="font-semibold text-white break-words">{[hardware.bios.vendor, hardware.bios.version].filter(Boolean).join(' ') || 'Unknown'}</p>
+                    {hardware.bios.date && <p className="text-xs text-gray-400 mt-1">{hardware.bios.date}</p>}
+                  </div>
+                  <div className="bg-gray-700 rounded-lg p-4">
+                    <p className="text-sm text-gray-400 mb-1">Chassis</p>
+                    <p className="font-semibold text-white break-words">{hardware.chassis.type || 'Unknown'}</p>
+                  </div>
+                </div>
+              )}
+              <h4 className="text-lg sm:text-xl font-semibold text-white mb-3 sm:mb-4">Network Interfaces</h4>
+              {network && network.length > 0 ? (
                 <div className="grid grid-cols-1 lg:grid-cols-2 gap-3 sm:gap-4">
-                  {hardware.map((hw, index) => (
+                  {network.map((hw, index) => (
                     <div key={index} className="bg-gray-700 rounded-lg p-4 border border-gray-600">
                       <div className="flex items-center gap-2 mb-3">
                         <span className="text-lg sm:text-xl text-blue-400">🌐</span>
@@ -586,8 +619,8 @@ export default function Dashboard({
                         </div>
                         <div className="flex flex-col sm:flex-row sm:justify-between gap-1 sm:gap-0">
                           <span className="text-gray-400">Flags:</span>
-                          <span className="font-medium text-white break-words" title={hw.flags}>
-                            {hw.flags}
+                          <span className="font-medium text-white break-words" title={hw.flags.join(', ')}>
+                            {hw.flags.join(', ')}
                           </span>
                         </div>
                       </div>
@@ -596,9 +629,45 @@ export default function Dashboard({
                 </div>
               ) : (
                 <div className="text-center py-8">
-                  <p className="text-gray-400 text-base sm:text-lg">No hardware information available</p>
+                  <p className="text-gray-400 text-base sm:text-lg">No network interfaces available</p>
                 </div>
               )}
+              {pci && pci.length > 0 && (
+                <>
+                  <h4 className="text-lg sm:text-xl font-semibold text-white mt-6 mb-3 sm:mb-4">PCI Devices</h4>
+                  <div className="space-y-2">
+                    {pci.map((device) => (
+                      <div key={device.address} className="bg-gray-700 rounded-lg p-3 text-xs sm:text-sm flex flex-col sm:flex-row sm:justify-between gap-1">
+                        <span className="text-white break-words">
+                          <span className="text-gray-400 mr-2">{device.address}</span>
+                          {[device.vendor, device.device].filter(Boolean).join(' ') || `${device.vendor_id}:${device.device_id}`}
+                        </span>
+                        <span className="text-gray-400 break-words">
+                          {[device.class_name || device.class, device.driver].filter(Boolean).join(' · ')}
+                        </span>
+                      </div>
+                    ))}
+                  </div>
+                </>
+              )}
+              {usb && usb.length > 0 && (
+                <>
+                  <h4 className="text-lg sm:text-xl font-semibold text-white mt-6 mb-3 sm:mb-4">USB Devices</h4>
+                  <div className="space-y-2">
+                    {usb.map((device) => (
+                      <div key={device.port} className="bg-gray-700 rounded-lg p-3 text-xs sm:text-sm flex flex-col sm:flex-row sm:justify-between gap-1">
+                        <span className="text-white break-words">
+                          <span className="text-gray-400 mr-2">{device.port}</span>
+                          {[device.vendor, device.product].filter(Boolean).join(' ') || `${device.vendor_id}:${device.product_id}`}
+                        </span>
+                        <span className="text-gray-400 break-words">
+                          {[device.class_name || device.class, device.speed_mbps ? `${device.speed_mbps} Mbps` : '', device.drivers.join(', ')].filter(Boolean).join(' · ')}
+                        </span>
+                      </div>
+                    ))}
+                  </div>
+                </>
+              )}
             </div>
           )}
 
@@ -14,6 +14,7 @@ const ScheduleForm: React.FC<ScheduleFormProps> = ({ schedule, onSubmit, onCance
     end_time: '',
     repeat_pattern: 'once',
     enabled: true,
+    require_ac_power: false,
   });
 
   const [errors, setErrors] = useState<Record<string, string>>({});
@@ -27,6 +28,7 @@ const ScheduleForm: React.FC<ScheduleFormProps> = ({ schedule, onSubmit, onCance
         end_time: schedule.end_time.slice(0, 16),
         repeat_pattern: schedule.repeat_pattern,
         enabled: schedule.enabled,
+        require_ac_power: schedule.require_ac_power,
       });
     }
   }, [schedule]);
@@ -200,6 +202,20 @@ const ScheduleForm: React.FC<ScheduleFormProps> = ({ schedule, onSubmit, onCance
           </label>
         </div>
 
+        {/* AC Power Toggle */}
+        <div className="flex items-center">
+          <input
+            type="checkbox"
+            id="require_ac_power"
+            checked={formData.require_ac_power}
+            onChange={(e) => handleInputChange('require_ac_power', e.target.checked)}
+            className="w-4 h-4 text-purple-600 bg-gray-700 border-gray-600 rounded focus:ring-purple-500 focus:ring-2"
+          />
+          <label htmlFor="require_ac_power" className="ml-2 text-sm font-medium text-gray-300">
+            Only run when plugged in
+          </label>
+        </div>
+
         {/* Form Actions */}
         <div className="flex gap-4 pt-4">
           <button
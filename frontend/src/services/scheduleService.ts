@@ -9,6 +9,7 @@ const convertWailsSchedule = (wailsSchedule: database.Schedule): Schedule => ({
   end_time: wailsSchedule.end_time,
   repeat_pattern: wailsSchedule.repeat_pattern as 'once' | 'daily' | 'weekly',
   enabled: wailsSchedule.enabled,
+  require_ac_power: wailsSchedule.require_ac_power,
   created_at: wailsSchedule.created_at,
 });
 
@@ -21,6 +22,7 @@ const convertToWailsSchedule = (schedule: Schedule): database.Schedule => {
   wailsSchedule.end_time = schedule.end_time;
   wailsSchedule.repeat_pattern = schedule.repeat_pattern;
   wailsSchedule.enabled = schedule.enabled;
+  wailsSchedule.require_ac_power = schedule.require_ac_power;
   wailsSchedule.created_at = schedule.created_at;
   return wailsSchedule;
 };
@@ -34,6 +36,7 @@ export const addSchedule = async (schedule: Omit<Schedule, 'id' | 'created_at'>)
   wailsSchedule.end_time = schedule.end_time;
   wailsSchedule.repeat_pattern = schedule.repeat_pattern;
   wailsSchedule.enabled = schedule.enabled;
+  wailsSchedule.require_ac_power = schedule.require_ac_power;
   return AddSchedule(wailsSchedule);
 };
 
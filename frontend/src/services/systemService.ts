@@ -5,11 +5,32 @@ import {
   GetGPUInfo,
   GetMemoryInfo,
   GetDiskInfo,
+  GetDiskPartitions,
+  GetDiskIO,
   GetLocationInfo,
   GetHardwareInfo,
-  GetUsagePercentages
+  GetPCIDevices,
+  GetUSBDevices,
+  GetNetworkInterfaces,
+  GetSensors,
+  GetPowerStatus,
+  GetCgroup,
+  ListCollectors,
+  Collect,
+  GetUsagePercentages,
+  GetUsageHistory,
+  GetProcesses,
+  GetProcessDetail,
+  GetProcessAudit,
+  ConfirmProcessAction,
+  SignalProcess,
+  ReniceProcess,
+  WaitForProcessExit,
+  StartLiveUsage,
+  StopLiveUsage
 } from "../../wailsjs/go/app/App";
-import { SystemInfo, OSInfo, CPUInfo, GPUInfo, MemoryInfo, DiskInfo, LocationInfo, HardwareInfo, UsagePercentages } from "../types/system";
+import { EventsOn } from "../../wailsjs/runtime/runtime";
+import { SystemInfo, OSInfo, CPUInfo, GPUInfo, MemoryInfo, DiskInfo, DiskPartition, DiskIO, LocationInfo, HardwareInfo, PCIDevice, USBDevice, NetworkInterface, Sensors, PowerStatus, Cgroup, CollectorInfo, UsagePercentages, UsageHistory, UsageSample, UsageAlert, ProcessList, ProcessDetail, ProcessAction, ProcessConfirmation, ProcessActionResult, ProcessWaitResult, ProcessAuditEntry } from "../types/system";
 
 export async function getSystemInfo(): Promise<SystemInfo> {
   const data = await GetAllSystemInfo();
@@ -41,17 +62,133 @@ export async function getDiskInfo(): Promise<DiskInfo> {
   return data as DiskInfo;
 }
 
+export async function getDiskPartitions(): Promise<DiskPartition[]> {
+  const data = await GetDiskPartitions();
+  return data as DiskPartition[];
+}
+
+export async function getDiskIO(): Promise<DiskIO> {
+  const data = await GetDiskIO();
+  return data as DiskIO;
+}
+
 export async function getLocationInfo(): Promise<LocationInfo> {
   const data = await GetLocationInfo();
   return data as LocationInfo;
 }
 
-export async function getHardwareInfo(): Promise<HardwareInfo[]> {
+export async function getHardwareInfo(): Promise<HardwareInfo> {
   const data = await GetHardwareInfo();
-  return data as HardwareInfo[];
+  return data as HardwareInfo;
+}
+
+export async function getPCIDevices(): Promise<PCIDevice[]> {
+  const data = await GetPCIDevices();
+  return data as PCIDevice[];
+}
+
+export async function getUSBDevices(): Promise<USBDevice[]> {
+  const data = await GetUSBDevices();
+  return data as USBDevice[];
+}
+
+export async function getNetworkInterfaces(): Promise<NetworkInterface[]> {
+  const data = await GetNetworkInterfaces();
+  return data as NetworkInterface[];
+}
+
+export async function getSensors(): Promise<Sensors> {
+  const data = await GetSensors();
+  return data as Sensors;
+}
+
+export async function getPowerStatus(): Promise<PowerStatus> {
+  const data = await GetPowerStatus();
+  return data as PowerStatus;
+}
+
+export async function getCgroup(): Promise<Cgroup> {
+  const data = await GetCgroup();
+  return data as Cgroup;
+}
+
+export async function listCollectors(): Promise<CollectorInfo[]> {
+  const data = await ListCollectors();
+  return data as CollectorInfo[];
+}
+
+export async function collect<T = unknown>(name: string): Promise<T> {
+  const data = await Collect(name);
+  return data as T;
 }
 
 export async function getUsagePercentages(): Promise<UsagePercentages> {
   const data = await GetUsagePercentages();
   return data as UsagePercentages;
 }
+
+// getUsageHistory returns bucketed usage between from and to (unix seconds).
+// Pass 0 for any argument to use the backend defaults.
+export async function getUsageHistory(from = 0, to = 0, step = 0): Promise<UsageHistory> {
+  const data = await GetUsageHistory(from, to, step);
+  return data as UsageHistory;
+}
+
+// getProcesses returns processes sorted by sort ("cpu", "memory", "io", "start") in order ("asc", "desc").
+// Empty strings and a zero limit use the backend defaults.
+export async function getProcesses(sort = "", order = "", name = "", user = "", limit = 0): Promise<ProcessList> {
+  const data = await GetProcesses(sort, order, name, user, limit);
+  return data as ProcessList;
+}
+
+export async function getProcessDetail(pid: number): Promise<ProcessDetail> {
+  const data = await GetProcessDetail(pid);
+  return data as ProcessDetail;
+}
+
+// confirmProcessAction returns a single-use token that must be passed to signalProcess or reniceProcess.
+// Show the returned process name to the user before using the token.
+export async function confirmProcessAction(pid: number, action: ProcessAction, nice = 0): Promise<ProcessConfirmation> {
+  const data = await ConfirmProcessAction(pid, action, nice);
+  return data as ProcessConfirmation;
+}
+
+export async function signalProcess(pid: number, signal: Exclude<ProcessAction, "renice">, token: string): Promise<ProcessActionResult> {
+  const data = await SignalProcess(pid, signal, token);
+  return data as ProcessActionResult;
+}
+
+export async function reniceProcess(pid: number, nice: number, token: string): Promise<ProcessActionResult> {
+  const data = await ReniceProcess(pid, nice, token);
+  return data as ProcessActionResult;
+}
+
+// waitForProcessExit waits up to timeout seconds (0 for the backend default) for pid to exit
+export async function waitForProcessExit(pid: number, timeout = 0): Promise<ProcessWaitResult> {
+  const data = await WaitForProcessExit(pid, timeout);
+  return data as ProcessWaitResult;
+}
+
+export async function getProcessAudit(limit = 0): Promise<ProcessAuditEntry[]> {
+  const data = await GetProcessAudit(limit);
+  return (data ?? []) as ProcessAuditEntry[];
+}
+
+// subscribeLiveUsage starts backend usage events every interval seconds (0 for the default rate)
+// and returns a function that unsubscribes and stops the backend sampler.
+export function subscribeLiveUsage(
+  interval: number,
+  onUsage: (sample: UsageSample) => void,
+  onAlert?: (alert: UsageAlert) => void
+): () => void {
+  const offUsage = EventsOn("system:usage", onUsage);
+  const offAlert = onAlert ? EventsOn("system:alert", onAlert) : () => {};
+
+  StartLiveUsage(interval).catch(err => console.error("Live usage error:", err));
+
+  return () => {
+    offUsage();
+    offAlert();
+    StopLiveUsage().catch(err => console.error("Live usage error:", err));
+  };
+}
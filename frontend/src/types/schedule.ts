@@ -5,6 +5,7 @@ export interface Schedule {
   end_time: string;
   repeat_pattern: 'once' | 'daily' | 'weekly';
   enabled: boolean;
+  require_ac_power: boolean;
   created_at: string;
 }
 
@@ -14,4 +15,5 @@ export interface ScheduleFormData {
   end_time: string;
   repeat_pattern: 'once' | 'daily' | 'weekly';
   enabled: boolean;
+  require_ac_power: boolean;
 }
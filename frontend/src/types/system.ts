@@ -6,6 +6,31 @@ export interface CPUInfo {
   cache_size: string;
   ghz: string;
   cpu_usage_percentage: string;
+  physical_cores: number;
+  logical_cores: number;
+  sockets: number;
+  per_core_usage: number[];
+  times: CPUTimePercentages;
+  load_average: LoadAverage;
+  context_switches_per_sec: number;
+  interrupts_per_sec: number;
+}
+
+export interface CPUTimePercentages {
+  user: number;
+  system: number;
+  idle: number;
+  nice: number;
+  iowait: number;
+  irq: number;
+  softirq: number;
+  steal: number;
+}
+
+export interface LoadAverage {
+  load1: number;
+  load5: number;
+  load15: number;
 }
 
 export interface GPUInfo {
@@ -14,6 +39,7 @@ export interface GPUInfo {
   driver: string;
   usage_percentage: string;
   clock_speed: string;
+  pci_address?: string;
 }
 
 export interface OSInfo {
@@ -25,6 +51,34 @@ export interface OSInfo {
   kernel_version: string;
   kernel_arch: string;
   uptime: number;
+  boot_time: string;
+  host_id: string;
+  process_count: number;
+  virtualization_system: string;
+  virtualization_role: string;
+  init_system?: string;
+  timezone: string;
+  utc_offset_seconds: number;
+  locale: string;
+  users: SessionUser[];
+  containerized: boolean;
+  container_runtime?: string;
+  host_roots: HostRoots;
+}
+
+export interface SessionUser {
+  user: string;
+  terminal: string;
+  host: string;
+  started: string;
+}
+
+export interface HostRoots {
+  proc: string;
+  sys: string;
+  etc: string;
+  run: string;
+  cgroup: string;
 }
 
 export interface LocationInfo {
@@ -46,6 +100,35 @@ export interface MemoryInfo {
   free: string;
   available: string;
   used_percentage: string;
+  buffers: string;
+  cached: string;
+  shared: string;
+  slab: string;
+  swap_total: string;
+  swap_used: string;
+  swap_used_percentage: string;
+  swap_in: string;
+  swap_out: string;
+  major_faults: string;
+  pressure?: PressureStall;
+}
+
+export interface StallAverages {
+  avg10: string;
+  avg60: string;
+  avg300: string;
+  total: string;
+}
+
+export interface ResourceStall {
+  some: StallAverages;
+  full?: StallAverages;
+}
+
+export interface PressureStall {
+  cpu?: ResourceStall;
+  memory?: ResourceStall;
+  io?: ResourceStall;
 }
 
 export interface DiskInfo {
@@ -55,22 +138,241 @@ export interface DiskInfo {
   used_percentage: string;
 }
 
-export interface HardwareInfo {
+export interface DiskPartition {
+  device: string;
+  mountpoint: string;
+  fstype: string;
+  options: string[];
+  read_only: boolean;
+  total: number;
+  used: number;
+  free: number;
+  used_percent: number;
+  inodes_total: number;
+  inodes_used: number;
+  inodes_free: number;
+  inodes_used_percent: number;
+}
+
+export interface DiskIODevice {
+  name: string;
+  read_bytes_per_sec: number;
+  write_bytes_per_sec: number;
+  read_iops: number;
+  write_iops: number;
+  read_latency_ms: number;
+  write_latency_ms: number;
+  utilization_percent: number;
+}
+
+export interface DiskIO {
+  sampled_at: string;
+  interval_seconds: number;
+  devices: DiskIODevice[];
+}
+
+export interface NetworkAddress {
+  address: string;
+  prefix: number;
+  family: "ipv4" | "ipv6";
+}
+
+export interface NetworkTraffic {
+  bytes_sent: number;
+  bytes_recv: number;
+  packets_sent: number;
+  packets_recv: number;
+  errors_in: number;
+  errors_out: number;
+  drops_in: number;
+  drops_out: number;
+}
+
+export interface NetworkInterface {
+  name: string;
   index: number;
   mtu: number;
-  name: string;
   hardware_addr: string;
-  flags: string;
+  flags: string[];
+  addresses: NetworkAddress[];
+  speed_mbps: number;
+  duplex?: "full" | "half";
+  counters: NetworkTraffic;
+  // Same fields as counters, per second
+  rates: NetworkTraffic;
+}
+
+export interface TemperatureSensor {
+  key: string;
+  celsius: number;
+  high?: number;
+  critical?: number;
 }
 
+export interface FanSensor {
+  chip: string;
+  label: string;
+  rpm: number;
+  min?: number;
+  max?: number;
+}
+
+export interface VoltageSensor {
+  chip: string;
+  label: string;
+  volts: number;
+  min?: number;
+  max?: number;
+  critical?: number;
+}
+
+export interface Sensors {
+  temperatures: TemperatureSensor[];
+  fans: FanSensor[];
+  voltages: VoltageSensor[];
+}
+
+export interface Battery {
+  name: string;
+  status: string;
+  charge_percent: number;
+  rate_watts: number;
+  energy_wh: number;
+  energy_full_wh: number;
+  energy_design_wh: number;
+  health_percent: number;
+  cycle_count: number;
+  time_remaining_seconds?: number;
+}
+
+export interface PowerStatus {
+  ac_online?: boolean;
+  on_battery: boolean;
+  batteries: Battery[];
+}
+
+export interface CgroupCPU {
+  quota_us: number | null;
+  period_us: number;
+  cpuset_cpus?: number;
+  effective_cpus: number;
+  usage_seconds: number;
+  periods: number;
+  throttled_periods: number;
+  throttled_seconds: number;
+}
+
+export interface CgroupMemory {
+  limit_bytes: number | null;
+  soft_limit_bytes: number | null;
+  usage_bytes: number;
+  peak_bytes: number | null;
+  oom_events: number;
+  oom_kills: number;
+}
+
+export interface CgroupPids {
+  limit: number | null;
+  current: number;
+}
+
+// Version is 0 and the controllers are omitted outside Linux
+export interface Cgroup {
+  version: 0 | 1 | 2;
+  path?: string;
+  cpu?: CgroupCPU;
+  memory?: CgroupMemory;
+  pids?: CgroupPids;
+}
+
+// DMI/SMBIOS inventory; fields are empty outside Linux or when the firmware leaves them unset
+export interface HardwareInfo {
+  system: {
+    vendor: string;
+    product: string;
+    version: string;
+    family: string;
+    serial?: string;
+  };
+  board: {
+    vendor: string;
+    name: string;
+    version: string;
+    serial?: string;
+  };
+  bios: {
+    vendor: string;
+    version: string;
+    date: string;
+  };
+  chassis: {
+    type: string;
+    vendor: string;
+    version: string;
+  };
+}
+
+// IDs are lowercase hexadecimal; names are empty when the bundled ID database does not list them
+export interface PCIDevice {
+  address: string;
+  vendor_id: string;
+  device_id: string;
+  vendor: string;
+  device: string;
+  subsystem_vendor_id?: string;
+  subsystem_device_id?: string;
+  class: string;
+  class_name: string;
+  revision?: string;
+  driver?: string;
+}
+
+export interface USBDevice {
+  port: string;
+  bus: number;
+  device_number: number;
+  vendor_id: string;
+  product_id: string;
+  vendor: string;
+  product: string;
+  serial?: string;
+  class: string;
+  class_name: string;
+  usb_version: string;
+  speed_mbps: number;
+  drivers: string[];
+}
+
+export interface SectionError {
+  code: 'timeout' | 'canceled' | 'network_error' | 'collector_error';
+  message: string;
+  duration_ms: number;
+}
+
+// Sections that failed are omitted and described in errors
 export interface SystemInfo {
-  cpu: CPUInfo;
-  gpus: GPUInfo[];
-  os: OSInfo;
-  location: LocationInfo;
-  memory: MemoryInfo;
-  disk: DiskInfo;
-  hardware: HardwareInfo[];
+  status: 'complete' | 'partial' | 'failed';
+  errors: Record<string, SectionError>;
+  cpu?: CPUInfo;
+  gpus?: GPUInfo[];
+  os?: OSInfo;
+  location?: LocationInfo;
+  memory?: MemoryInfo;
+  disk?: DiskInfo;
+  hardware?: HardwareInfo;
+  disk_partitions?: DiskPartition[];
+  disk_io?: DiskIO;
+  network?: NetworkInterface[];
+  sensors?: Sensors;
+  power?: PowerStatus;
+  cgroup?: Cgroup;
+  pci?: PCIDevice[];
+  usb?: USBDevice[];
+}
+
+export interface CollectorInfo {
+  name: string;
+  ttl_seconds: number;
 }
 
 export interface AllSystemData {
@@ -81,7 +383,10 @@ export interface AllSystemData {
   location: LocationInfo | null;
   memory: MemoryInfo | null;
   disk: DiskInfo | null;
-  hardware: HardwareInfo[] | null;
+  hardware: HardwareInfo | null;
+  network: NetworkInterface[] | null;
+  pci: PCIDevice[] | null;
+  usb: USBDevice[] | null;
   usagePercentages: UsagePercentages | null;
 }
 
@@ -90,6 +395,116 @@ export interface UsagePercentages {
   gpu_usage: string;
   memory_usage: string;
   disk_usage: string;
+  relative_to: 'host' | 'cgroup';
+}
+
+export interface UsageSample {
+  timestamp: string;
+  window_seconds: number;
+  cpu: number;
+  gpu: number;
+  memory: number;
+  disk: number;
+  disk_read_bps: number;
+  disk_write_bps: number;
+}
+
+export interface UsageAlert {
+  metric: "cpu" | "gpu" | "memory" | "disk";
+  value: number;
+  threshold: number;
+  timestamp: string;
+}
+
+export interface UsagePoint {
+  timestamp: string;
+  cpu: number;
+  gpu: number;
+  memory: number;
+  disk: number;
+  disk_read_bps: number;
+  disk_write_bps: number;
+  samples: number;
+}
+
+export interface UsageHistory {
+  from: string;
+  to: string;
+  step: number;
+  series: UsagePoint[];
+}
+
+export interface ProcessSummary {
+  pid: number;
+  ppid: number;
+  name: string;
+  username: string;
+  status: string;
+  cpu_percent: number;
+  memory_percent: number;
+  memory_rss: number;
+  io_read_bytes: number;
+  io_write_bytes: number;
+  num_threads: number;
+  start_time: string;
+}
+
+export interface ProcessList {
+  total: number;
+  processes: ProcessSummary[];
+}
+
+export interface ProcessConnection {
+  type: string;
+  status: string;
+  local_addr: string;
+  remote_addr: string;
+}
+
+export interface ProcessDetail extends ProcessSummary {
+  exe: string;
+  cmdline: string;
+  cwd: string;
+  nice: number;
+  open_files: number;
+  children: number[];
+  connections: ProcessConnection[];
+}
+
+export type ProcessAction = "SIGTERM" | "SIGKILL" | "SIGSTOP" | "SIGCONT" | "renice";
+
+export interface ProcessConfirmation {
+  token: string;
+  pid: number;
+  name: string;
+  action: ProcessAction;
+  nice?: number;
+  expires_at: string;
+}
+
+export interface ProcessActionResult {
+  pid: number;
+  name: string;
+  action: ProcessAction;
+  performed_at: string;
+}
+
+export interface ProcessWaitResult {
+  pid: number;
+  exited: boolean;
+  waited_ms: number;
+}
+
+export interface ProcessAuditEntry {
+  id: number;
+  performed_at: string;
+  pid: number;
+  process_name: string;
+  action: string;
+  detail?: string;
+  outcome: "succeeded" | "failed" | "refused";
+  error?: string;
+  source: "api" | "desktop";
 }
 
 export interface User {
@@ -4,14 +4,24 @@ import {database} from '../models';
 
 export function AddSchedule(arg1:database.Schedule):Promise<void>;
 
+export function Collect(arg1:string):Promise<any>;
+
+export function ConfirmProcessAction(arg1:number,arg2:string,arg3:number):Promise<any>;
+
 export function DeleteSchedule(arg1:number):Promise<void>;
 
 export function GetAllSystemInfo():Promise<any>;
 
 export function GetCPUInfo():Promise<any>;
 
+export function GetCgroup():Promise<any>;
+
+export function GetDiskIO():Promise<any>;
+
 export function GetDiskInfo():Promise<any>;
 
+export function GetDiskPartitions():Promise<any>;
+
 export function GetGPUInfo():Promise<any>;
 
 export function GetHardwareInfo():Promise<any>;
@@ -20,16 +30,46 @@ export function GetLocationInfo():Promise<any>;
 
 export function GetMemoryInfo():Promise<any>;
 
+export function GetNetworkInterfaces():Promise<any>;
+
 export function GetOSInfo():Promise<any>;
 
+export function GetPCIDevices():Promise<any>;
+
+export function GetPowerStatus():Promise<any>;
+
+export function GetProcessAudit(arg1:number):Promise<any>;
+
+export function GetProcessDetail(arg1:number):Promise<any>;
+
+export function GetProcesses(arg1:string,arg2:string,arg3:string,arg4:string,arg5:number):Promise<any>;
+
+export function GetSensors():Promise<any>;
+
+export function GetUSBDevices():Promise<any>;
+
+export function GetUsageHistory(arg1:number,arg2:number,arg3:number):Promise<any>;
+
 export function GetUsagePercentages():Promise<any>;
 
+export function ListCollectors():Promise<any>;
+
 export function ListSchedules():Promise<Array<database.Schedule>>;
 
 export function OnURL(arg1:string):Promise<void>;
 
+export function ReniceProcess(arg1:number,arg2:number,arg3:string):Promise<any>;
+
+export function SignalProcess(arg1:number,arg2:string,arg3:string):Promise<any>;
+
+export function StartLiveUsage(arg1:number):Promise<void>;
+
+export function StopLiveUsage():Promise<void>;
+
 export function SyncWithSystem():Promise<void>;
 
 export function ToggleSchedule(arg1:number,arg2:boolean):Promise<void>;
 
 export function UpdateSchedule(arg1:database.Schedule):Promise<void>;
+
+export function WaitForProcessExit(arg1:number,arg2:number):Promise<any>;
@@ -6,6 +6,14 @@ export function AddSchedule(arg1) {
   return window['go']['app']['App']['AddSchedule'](arg1);
 }
 
+export function Collect(arg1) {
+  return window['go']['app']['App']['Collect'](arg1);
+}
+
+export function ConfirmProcessAction(arg1, arg2, arg3) {
+  return window['go']['app']['App']['ConfirmProcessAction'](arg1, arg2, arg3);
+}
+
 export function DeleteSchedule(arg1) {
   return window['go']['app']['App']['DeleteSchedule'](arg1);
 }
@@ -18,10 +26,22 @@ export function GetCPUInfo() {
   return window['go']['app']['App']['GetCPUInfo']();
 }
 
+export function GetCgroup() {
+  return window['go']['app']['App']['GetCgroup']();
+}
+
+export function GetDiskIO() {
+  return window['go']['app']['App']['GetDiskIO']();
+}
+
 export function GetDiskInfo() {
   return window['go']['app']['App']['GetDiskInfo']();
 }
 
+export function GetDiskPartitions() {
+  return window['go']['app']['App']['GetDiskPartitions']();
+}
+
 export function GetGPUInfo() {
   return window['go']['app']['App']['GetGPUInfo']();
 }
@@ -38,14 +58,54 @@ export function GetMemoryInfo() {
   return window['go']['app']['App']['GetMemoryInfo']();
 }
 
+export function GetNetworkInterfaces() {
+  return window['go']['app']['App']['GetNetworkInterfaces']();
+}
+
 export function GetOSInfo() {
   return window['go']['app']['App']['GetOSInfo']();
 }
 
+export function GetPCIDevices() {
+  return window['go']['app']['App']['GetPCIDevices']();
+}
+
+export function GetPowerStatus() {
+  return window['go']['app']['App']['GetPowerStatus']();
+}
+
+export function GetProcessAudit(arg1) {
+  return window['go']['app']['App']['GetProcessAudit'](arg1);
+}
+
+export function GetProcessDetail(arg1) {
+  return window['go']['app']['App']['GetProcessDetail'](arg1);
+}
+
+export function GetProcesses(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['app']['App']['GetProcesses'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function GetSensors() {
+  return window['go']['app']['App']['GetSensors']();
+}
+
+export function GetUSBDevices() {
+  return window['go']['app']['App']['GetUSBDevices']();
+}
+
+export function GetUsageHistory(arg1, arg2, arg3) {
+  return window['go']['app']['App']['GetUsageHistory'](arg1, arg2, arg3);
+}
+
 export function GetUsagePercentages() {
   return window['go']['app']['App']['GetUsagePercentages']();
 }
 
+export function ListCollectors() {
+  return window['go']['app']['App']['ListCollectors']();
+}
+
 export function ListSchedules() {
   return window['go']['app']['App']['ListSchedules']();
 }
@@ -54,6 +114,22 @@ export function OnURL(arg1) {
   return window['go']['app']['App']['OnURL'](arg1);
 }
 
+export function ReniceProcess(arg1, arg2, arg3) {
+  return window['go']['app']['App']['ReniceProcess'](arg1, arg2, arg3);
+}
+
+export function SignalProcess(arg1, arg2, arg3) {
+  return window['go']['app']['App']['SignalProcess'](arg1, arg2, arg3);
+}
+
+export function StartLiveUsage(arg1) {
+  return window['go']['app']['App']['StartLiveUsage'](arg1);
+}
+
+export function StopLiveUsage() {
+  return window['go']['app']['App']['StopLiveUsage']();
+}
+
 export function SyncWithSystem() {
   return window['go']['app']['App']['SyncWithSystem']();
 }
@@ -65,3 +141,7 @@ export function ToggleSchedule(arg1, arg2) {
 export function UpdateSchedule(arg1) {
   return window['go']['app']['App']['UpdateSchedule'](arg1);
 }
+
+export function WaitForProcessExit(arg1, arg2) {
+  return window['go']['app']['App']['WaitForProcessExit'](arg1, arg2);
+}
@@ -9,6 +9,7 @@ export namespace database {
 	    end_time: any;
 	    repeat_pattern: string;
 	    enabled: boolean;
+	    require_ac_power: boolean;
 	    // Go type: time
 	    created_at: any;
 	
@@ -24,6 +25,7 @@ export namespace database {
 	        this.end_time = this.convertValues(source["end_time"], null);
 	        this.repeat_pattern = source["repeat_pattern"];
 	        this.enabled = source["enabled"];
+	        this.require_ac_power = source["require_ac_power"];
 	        this.created_at = this.convertValues(source["created_at"], null);
 	    }
 	